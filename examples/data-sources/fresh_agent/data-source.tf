@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+# Resolve the ID of an agent instead of looking it up manually.
+data "fresh_agent" "alex" {
+  email = "alex.admin@example.com"
+}
+
+data "fresh_asset_type" "laptop" {
+  name = "Laptop"
+}
+
+resource "fresh_asset" "laptop" {
+  name          = "laptop-0042"
+  asset_type_id = data.fresh_asset_type.laptop.id
+  user_id       = data.fresh_agent.alex.id
+}
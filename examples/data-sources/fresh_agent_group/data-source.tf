@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_agent_group" "service_desk" {
+  name = "Service Desk"
+}
+
+output "service_desk_members" {
+  value = data.fresh_agent_group.service_desk.members
+}
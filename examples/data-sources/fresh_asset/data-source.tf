@@ -11,6 +11,11 @@ data "fresh_asset" "test" {
   display_id = "7308"
 }
 
+# Look up an asset by its asset tag instead, name and serial_number work too.
+data "fresh_asset" "by_tag" {
+  asset_tag = "ASSET-7308"
+}
+
 output "test" {
   value = data.fresh_asset.test.name
 }
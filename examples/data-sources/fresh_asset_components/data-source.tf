@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_asset" "host" {
+  name = "esxi-01"
+}
+
+data "fresh_asset_components" "host" {
+  display_id = data.fresh_asset.host.display_id
+}
+
+data "fresh_asset_components" "processors" {
+  display_id     = data.fresh_asset.host.display_id
+  component_type = "Processor"
+}
+
+# Total cores of the host.
+output "cores" {
+  value = sum([for processor in data.fresh_asset_components.processors.components : lookup(jsondecode(processor.component_data_json), "no_of_cores", 0)])
+}
+
+output "component_types" {
+  value = distinct([for component in data.fresh_asset_components.host.components : component.component_type])
+}
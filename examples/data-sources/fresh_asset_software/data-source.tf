@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_asset" "laptop" {
+  name = "laptop-0042"
+}
+
+data "fresh_asset_software" "laptop" {
+  display_id = data.fresh_asset.laptop.display_id
+}
+
+# Installed versions by software name.
+output "versions" {
+  value = { for software in data.fresh_asset_software.laptop.software : software.name => software.version }
+}
+
+output "blacklisted" {
+  value = [for software in data.fresh_asset_software.laptop.software : software.name if software.status == "blacklisted"]
+}
@@ -11,6 +11,21 @@ data "fresh_asset_type" "Laptop" {
   name = "VMware VCenter VM"
 }
 
+# Names are only unique below a parent, scope the lookup to pick one.
+data "fresh_asset_type" "vm" {
+  name                 = "Virtual Machine"
+  parent_asset_type_id = data.fresh_asset_type.Laptop.parent_asset_type_id
+}
+
+# Look up an asset type by ID.
+data "fresh_asset_type" "by_id" {
+  id = 52000123456
+}
+
+output "ancestry" {
+  value = [for parent in data.fresh_asset_type.by_id.ancestry : parent.name]
+}
+
 output "name" {
   value = data.fresh_asset_type.Laptop.id
 
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_asset_type" "laptop" {
+  name = "Laptop"
+}
+
+data "fresh_asset_type_fields" "laptop" {
+  asset_type_id = data.fresh_asset_type.laptop.id
+}
+
+locals {
+  # Type fields of laptops keyed by the name used in type_fields.
+  laptop_fields = { for field in data.fresh_asset_type_fields.laptop.fields : field.name => field }
+}
+
+variable "laptop_type_fields" {
+  type    = map(string)
+  default = {}
+}
+
+resource "fresh_asset" "laptop" {
+  name          = "laptop-0001"
+  asset_type_id = data.fresh_asset_type.laptop.id
+
+  # Only send fields the asset type actually has.
+  type_fields = { for name, value in var.laptop_type_fields : name => value if contains(keys(local.laptop_fields), name) }
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+# Every asset type, e.g. to render the CMDB type hierarchy.
+data "fresh_asset_types" "all" {}
+
+# The visible root asset types.
+data "fresh_asset_types" "roots" {
+  parent_asset_type_id = 0
+  visible              = true
+}
+
+locals {
+  asset_types = { for asset_type in data.fresh_asset_types.all.asset_types : asset_type.id => asset_type }
+
+  # Full path of every asset type, e.g. "Hardware/Computer/Laptop".
+  asset_type_paths = {
+    for asset_type in data.fresh_asset_types.all.asset_types :
+    asset_type.id => join("/", concat(reverse([for parent in asset_type.ancestry : parent.name]), [asset_type.name]))
+  }
+}
+
+output "root_children" {
+  value = { for root in data.fresh_asset_types.roots.asset_types : root.name => [for id in root.child_asset_type_ids : local.asset_types[id].name] }
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_asset_type" "laptop" {
+  name = "Laptop"
+}
+
+# Every laptop in a location, including its custom fields.
+data "fresh_assets" "laptops" {
+  filter              = "asset_type_id:${data.fresh_asset_type.laptop.id} AND location_id:5"
+  include_type_fields = true
+}
+
+# Every asset whose name starts with web-.
+data "fresh_assets" "web" {
+  search = "name:'web-'"
+}
+
+output "laptops" {
+  value = [for asset in data.fresh_assets.laptops.assets : asset.name]
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+# Resolve the department ID of each tenant instead of hardcoding it.
+data "fresh_department" "finance" {
+  name = "Finance"
+}
+
+output "finance_department_id" {
+  value = data.fresh_department.finance.id
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+# Location names repeat below different parents, the path picks one.
+data "fresh_location" "office" {
+  path = "EU/Berlin/Office 3"
+}
+
+data "fresh_location" "headquarters" {
+  name = "Headquarters"
+}
+
+data "fresh_asset_type" "laptop" {
+  name = "Laptop"
+}
+
+resource "fresh_asset" "laptop" {
+  name          = "laptop-0042"
+  asset_type_id = data.fresh_asset_type.laptop.id
+  location_id   = data.fresh_location.office.id
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_relationship_types" "all" {}
+
+# Relationship type IDs keyed by their downstream relation, e.g. "Depends on".
+output "relationship_types" {
+  value = { for relationship_type in data.fresh_relationship_types.all.relationship_types : relationship_type.downstream_relation => relationship_type.id }
+}
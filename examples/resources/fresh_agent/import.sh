@@ -0,0 +1,2 @@
+# Agents are imported by their ID.
+terraform import fresh_agent.alex 50000678901
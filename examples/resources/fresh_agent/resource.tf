@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+resource "fresh_agent_group" "service_desk" {
+  name = "Service Desk"
+}
+
+resource "fresh_agent" "alex" {
+  email      = "alex.admin@example.com"
+  first_name = "Alex"
+  last_name  = "Admin"
+  job_title  = "IT Engineer"
+  member_of  = [fresh_agent_group.service_desk.id]
+
+  roles = [
+    {
+      role_id          = 50000012345
+      assignment_scope = "member_groups"
+    },
+    # Accounts with workspaces assign roles per workspace.
+    {
+      role_id          = 50000012346
+      assignment_scope = "specified_groups"
+      groups           = [fresh_agent_group.service_desk.id]
+      workspace_id     = 2
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Agent groups are imported by their ID.
+terraform import fresh_agent_group.service_desk 50000123456
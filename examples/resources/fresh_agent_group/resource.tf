@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+resource "fresh_agent_group" "service_desk" {
+  name        = "Service Desk"
+  description = "First line support"
+  members     = [50000111111, 50000222222]
+  leaders     = [50000111111]
+
+  # Escalate tickets nobody picked up within an hour to the group leader.
+  escalate_to    = 50000111111
+  unassigned_for = "1h"
+}
+
+data "fresh_asset_type" "laptop" {
+  name = "Laptop"
+}
+
+resource "fresh_asset" "laptop" {
+  name          = "laptop-0042"
+  asset_type_id = data.fresh_asset_type.laptop.id
+  group_id      = fresh_agent_group.service_desk.id
+}
@@ -21,4 +21,11 @@ resource "fresh_asset" "test" {
   name          = "TestAssetTerraform"
   asset_type_id = data.fresh_asset_type.vmware.id
   description   = "Description of TestAssetTerraform"
+  impact        = "medium"
+  usage_type    = "permanent"
+
+  # Custom fields of the asset type, without the asset type ID suffix.
+  type_fields = {
+    serial_number = "SN-0001"
+  }
 }
@@ -0,0 +1,2 @@
+# Asset relationships are imported by their ID.
+terraform import fresh_asset_relationship.vm_host 52000123456
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "1.0.0"
+    }
+  }
+}
+
+data "fresh_relationship_types" "all" {}
+
+locals {
+  relationship_types = { for relationship_type in data.fresh_relationship_types.all.relationship_types : relationship_type.downstream_relation => relationship_type.id }
+}
+
+data "fresh_asset_type" "vmware" {
+  name = "VMware VCenter VM"
+}
+
+resource "fresh_asset" "vm" {
+  name          = "web-01"
+  asset_type_id = data.fresh_asset_type.vmware.id
+}
+
+data "fresh_asset" "host" {
+  name = "esxi-01"
+}
+
+# The VM runs on the host.
+resource "fresh_asset_relationship" "vm_host" {
+  primary_id           = fresh_asset.vm.display_id
+  secondary_id         = data.fresh_asset.host.display_id
+  relationship_type_id = local.relationship_types["Runs On"]
+}
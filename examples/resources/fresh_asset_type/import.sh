@@ -0,0 +1,2 @@
+# Asset types are imported by their ID.
+terraform import fresh_asset_type.appliance 52000123456
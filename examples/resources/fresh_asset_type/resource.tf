@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "1.0.0"
+    }
+  }
+}
+
+data "fresh_asset_type" "hardware" {
+  name = "Hardware"
+}
+
+# Custom CI types are created below an existing asset type.
+resource "fresh_asset_type" "appliance" {
+  name                 = "Network Appliance"
+  description          = "Load balancers and firewalls"
+  parent_asset_type_id = data.fresh_asset_type.hardware.id
+}
+
+resource "fresh_asset_type" "load_balancer" {
+  name                 = "Load Balancer"
+  parent_asset_type_id = fresh_asset_type.appliance.id
+  visible              = true
+}
@@ -0,0 +1,2 @@
+# Departments are imported by their ID.
+terraform import fresh_department.finance 50000234567
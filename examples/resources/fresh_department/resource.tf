@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+resource "fresh_department" "finance" {
+  name        = "Finance"
+  description = "Accounting and payroll"
+  domains     = ["finance.example.com"]
+
+  custom_fields = {
+    cost_center = "CC-100"
+  }
+}
+
+data "fresh_asset_type" "laptop" {
+  name = "Laptop"
+}
+
+resource "fresh_asset" "laptop" {
+  name          = "laptop-0042"
+  asset_type_id = data.fresh_asset_type.laptop.id
+  department_id = fresh_department.finance.id
+}
@@ -0,0 +1,2 @@
+# Locations are imported by their ID.
+terraform import fresh_location.office 50000345678
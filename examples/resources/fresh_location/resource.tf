@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+resource "fresh_location" "eu" {
+  name = "EU"
+}
+
+resource "fresh_location" "berlin" {
+  name               = "Berlin"
+  parent_location_id = fresh_location.eu.id
+}
+
+resource "fresh_location" "office" {
+  name               = "Office 3"
+  parent_location_id = fresh_location.berlin.id
+  primary_contact_id = 50000456789
+
+  line1   = "Friedrichstraße 123"
+  line2   = "3rd floor"
+  city    = "Berlin"
+  country = "Germany"
+  zipcode = "10117"
+}
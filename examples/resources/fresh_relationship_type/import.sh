@@ -0,0 +1,2 @@
+# Relationship types are imported by their ID.
+terraform import fresh_relationship_type.hosts 52000123456
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "1.0.0"
+    }
+  }
+}
+
+# Keep the relationship types referenced by asset relationships the same in
+# every tenant.
+resource "fresh_relationship_type" "hosts" {
+  downstream_relation = "Hosts"
+  upstream_relation   = "Hosted on"
+  description         = "A host running virtual machines or containers"
+}
+
+resource "fresh_asset_relationship" "host_vm" {
+  primary_id           = 1001
+  secondary_id         = 1002
+  relationship_type_id = fresh_relationship_type.hosts.id
+}
@@ -0,0 +1,2 @@
+# Requester groups are imported by their ID.
+terraform import fresh_requester_group.berlin 50000345678
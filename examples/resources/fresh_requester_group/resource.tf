@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+# Members are added with fresh_requester_group_member.
+resource "fresh_requester_group" "berlin" {
+  name        = "Berlin Office"
+  description = "Requesters working from the Berlin office"
+}
+
+# The FreshService API cannot manage the rules of rule based groups, e.g.
+# matching a department or location, set them up in the FreshService UI after
+# the group is created.
+resource "fresh_requester_group" "finance" {
+  name = "Finance"
+  type = "rule_based"
+}
@@ -0,0 +1,2 @@
+# Requester group members are imported by the group ID and the requester ID.
+terraform import fresh_requester_group_member.jane 50000345678/50000456789
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+resource "fresh_requester_group" "berlin" {
+  name = "Berlin Office"
+}
+
+# Each membership is a separate resource, so different configurations can add
+# requesters to the same group without removing each other's members.
+resource "fresh_requester_group_member" "jane" {
+  requester_group_id = fresh_requester_group.berlin.id
+  requester_id       = 50000456789
+}
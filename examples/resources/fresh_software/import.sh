@@ -0,0 +1,3 @@
+# Software is imported by its ID, installations are only managed once they are
+# set in the configuration.
+terraform import fresh_software.office 50000123456
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    fresh = {
+      source  = "registry.terraform.io/rahmnstein/fresh"
+      version = "0.2.0"
+    }
+  }
+}
+
+data "fresh_asset" "laptop" {
+  name = "laptop-0042"
+}
+
+resource "fresh_software" "office" {
+  name        = "Microsoft Office 365"
+  description = "Office suite"
+  category    = "Productivity"
+
+  # Every installation not listed here is removed.
+  installations = [
+    {
+      display_id        = data.fresh_asset.laptop.display_id
+      version           = "16.0.17029"
+      installation_path = "C:\\Program Files\\Microsoft Office"
+      installation_date = "2023-11-24T10:00:00Z"
+    },
+  ]
+}
+
+resource "fresh_software" "torrent" {
+  name   = "uTorrent"
+  status = "blacklisted"
+}
@@ -0,0 +1,134 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// ListAgentsOptions narrows down the agents returned by ListAgents.
+type ListAgentsOptions struct {
+	// Email only lists the agent with this email address
+	Email string
+	// State only lists fulltime or occasional agents, see AgentStateFulltime
+	// and AgentStateOccasional
+	State string
+	// Inactive only lists deactivated agents
+	Inactive bool
+}
+
+// query returns the URL query for the options.
+func (options ListAgentsOptions) query() url.Values {
+	query := url.Values{}
+	if options.Email != "" {
+		query.Set("email", options.Email)
+	}
+	if options.State != "" {
+		query.Set("state", options.State)
+	}
+	if options.Inactive {
+		query.Set("active", "false")
+	}
+
+	return query
+}
+
+// ListAgents lists every agent matching options from the FreshService API.
+func (client *Client) ListAgents(ctx context.Context, options ListAgentsOptions) ([]AgentDetails, error) {
+	agentsURL := *client.APIEndpoint + "/agents"
+	if query := options.query().Encode(); query != "" {
+		agentsURL += "?" + query
+	}
+
+	return ListAll[AgentDetails](ctx, client, agentsURL, "agents")
+}
+
+// CreateAgent creates an agent in the FreshService API.
+func (client *Client) CreateAgent(ctx context.Context, agentDetails AgentDetails) (*AgentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/agents", agentDetails.ToAgentDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newAgent Agent
+	if err := json.NewDecoder(resp.Body).Decode(&newAgent); err != nil {
+		return nil, err
+	}
+
+	return &newAgent.AgentDetails, nil
+}
+
+// GetAgent gets an agent from the FreshService API, deactivated agents are
+// returned with Active unset.
+func (client *Client) GetAgent(ctx context.Context, id int64) (*AgentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/agents/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var agent Agent
+	if err := json.NewDecoder(resp.Body).Decode(&agent); err != nil {
+		return nil, err
+	}
+
+	return &agent.AgentDetails, nil
+}
+
+// UpdateAgent updates an agent in the FreshService API.
+func (client *Client) UpdateAgent(ctx context.Context, agentDetails AgentDetails) (*AgentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/agents/"+strconv.FormatInt(agentDetails.ID, 10), agentDetails.ToAgentDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedAgent Agent
+	if err := json.NewDecoder(resp.Body).Decode(&updatedAgent); err != nil {
+		return nil, err
+	}
+
+	return &updatedAgent.AgentDetails, nil
+}
+
+// DeactivateAgent deactivates an agent in the FreshService API, the agent
+// is kept and can no longer log in.
+func (client *Client) DeactivateAgent(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/agents/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ForgetAgent permanently deletes an agent and its personal data from the
+// FreshService API.
+func (client *Client) ForgetAgent(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/agents/"+strconv.FormatInt(id, 10)+"/forget", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ReactivateAgent reactivates a deactivated agent in the FreshService API.
+func (client *Client) ReactivateAgent(ctx context.Context, id int64) (*AgentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/agents/"+strconv.FormatInt(id, 10)+"/reactivate", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var agent Agent
+	if err := json.NewDecoder(resp.Body).Decode(&agent); err != nil {
+		return nil, err
+	}
+
+	return &agent.AgentDetails, nil
+}
@@ -0,0 +1,71 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// ListAgentGroups lists every agent group from the FreshService API.
+func (client *Client) ListAgentGroups(ctx context.Context) ([]AgentGroupDetails, error) {
+	return ListAll[AgentGroupDetails](ctx, client, *client.APIEndpoint+"/groups", "groups")
+}
+
+// CreateAgentGroup creates an agent group in the FreshService API.
+func (client *Client) CreateAgentGroup(ctx context.Context, agentGroupDetails AgentGroupDetails) (*AgentGroupDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/groups", agentGroupDetails.ToAgentGroupDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newAgentGroup AgentGroup
+	if err := json.NewDecoder(resp.Body).Decode(&newAgentGroup); err != nil {
+		return nil, err
+	}
+
+	return &newAgentGroup.AgentGroupDetails, nil
+}
+
+// GetAgentGroup gets an agent group from the FreshService API.
+func (client *Client) GetAgentGroup(ctx context.Context, id int64) (*AgentGroupDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/groups/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var agentGroup AgentGroup
+	if err := json.NewDecoder(resp.Body).Decode(&agentGroup); err != nil {
+		return nil, err
+	}
+
+	return &agentGroup.AgentGroupDetails, nil
+}
+
+// UpdateAgentGroup updates an agent group in the FreshService API.
+func (client *Client) UpdateAgentGroup(ctx context.Context, agentGroupDetails AgentGroupDetails) (*AgentGroupDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/groups/"+strconv.FormatInt(agentGroupDetails.ID, 10), agentGroupDetails.ToAgentGroupDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedAgentGroup AgentGroup
+	if err := json.NewDecoder(resp.Body).Decode(&updatedAgentGroup); err != nil {
+		return nil, err
+	}
+
+	return &updatedAgentGroup.AgentGroupDetails, nil
+}
+
+// DeleteAgentGroup deletes an agent group from the FreshService API.
+func (client *Client) DeleteAgentGroup(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/groups/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
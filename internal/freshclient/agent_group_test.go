@@ -0,0 +1,103 @@
+package freshclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// TestAgentGroupCRUD tests creating, updating and deleting an agent group.
+func TestAgentGroupCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateAgentGroup(ctx, AgentGroupDetails{
+		Name:        "TestGolang Service Desk",
+		Description: "Created by Go",
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(created.Members) != 0 || created.EscalateTo != 0 {
+		t.Errorf("freshclient.CreateAgentGroup() = %+v, want no members and no escalation", created)
+	}
+
+	if _, err := client.CreateAgentGroup(ctx, AgentGroupDetails{Name: "testgolang service desk"}); err == nil {
+		t.Errorf("freshclient.CreateAgentGroup() error = %v, want a duplicate name error", err)
+	}
+
+	created.Description = "Updated by Go"
+	updated, err := client.UpdateAgentGroup(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.Description != "Updated by Go" {
+		t.Errorf("freshclient.UpdateAgentGroup() = %+v, want the description updated", updated)
+	}
+
+	if err := client.DeleteAgentGroup(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetAgentGroup(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetAgentGroup() error = %v, want not found", err)
+	}
+}
+
+// TestAgentGroupMembership tests that the member lists are replaced and that
+// the escalation is cleared.
+func TestAgentGroupMembership(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	id := server.AddGroup("TestGolang Network", 11, 12)
+
+	group, err := client.GetAgentGroup(ctx, id)
+	if err != nil {
+		t.Errorf("freshclient.GetAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	group.Members = []int64{12, 13}
+	group.Leaders = []int64{13}
+	group.EscalateTo = 13
+	group.UnassignedFor = "1h"
+	updated, err := client.UpdateAgentGroup(ctx, *group)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if !reflect.DeepEqual(updated.Members, []int64{12, 13}) || !reflect.DeepEqual(updated.Leaders, []int64{13}) || updated.UnassignedFor != "1h" {
+		t.Errorf("freshclient.UpdateAgentGroup() = %+v, want members 12 and 13 led by 13 escalating after 1h", updated)
+	}
+
+	// Nil members are left alone, an empty list removes every member.
+	updated.Members = nil
+	kept, err := client.UpdateAgentGroup(ctx, *updated)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if !reflect.DeepEqual(kept.Members, []int64{12, 13}) {
+		t.Errorf("freshclient.UpdateAgentGroup() members = %v, want %v", kept.Members, []int64{12, 13})
+	}
+
+	updated.EscalateTo = 0
+	if _, err := client.UpdateAgentGroup(ctx, *updated); err == nil {
+		t.Errorf("freshclient.UpdateAgentGroup() error = %v, want an error escalating without an agent", err)
+	}
+
+	updated.UnassignedFor = ""
+	updated.Members = []int64{}
+	updated.Leaders = nil
+	cleared, err := client.UpdateAgentGroup(ctx, *updated)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAgentGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(cleared.Members) != 0 || cleared.EscalateTo != 0 || cleared.UnassignedFor != "" {
+		t.Errorf("freshclient.UpdateAgentGroup() = %+v, want no members and no escalation", cleared)
+	}
+}
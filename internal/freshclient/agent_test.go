@@ -0,0 +1,129 @@
+package freshclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// TestAgentCRUD tests creating, listing, updating, deactivating, reactivating and
+// forgetting an agent. Agents need a role of the account and take up a seat,
+// so only the fake API is used.
+func TestAgentCRUD(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	groupID := server.AddGroup("TestGolang Service Desk")
+
+	created, err := client.CreateAgent(ctx, AgentDetails{
+		Email:     "alex.admin@example.com",
+		FirstName: "Alex",
+		JobTitle:  "IT Engineer",
+		MemberOf:  []int64{groupID},
+		Roles: []AgentRole{
+			{RoleID: 3, AssignmentScope: AgentScopeSpecifiedGroups, Groups: []int64{groupID}, WorkspaceID: 2},
+		},
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if !created.Active || !reflect.DeepEqual(created.MemberOf, []int64{groupID}) || created.Roles[0].WorkspaceID != 2 {
+		t.Errorf("freshclient.CreateAgent() = %+v, want an active member of group %d with a role in workspace 2", created, groupID)
+	}
+
+	if _, err := client.CreateAgent(ctx, AgentDetails{Email: "Alex.Admin@example.com", FirstName: "Alex", Roles: created.Roles}); err == nil {
+		t.Errorf("freshclient.CreateAgent() error = %v, want a duplicate email error", err)
+	}
+
+	agents, err := client.ListAgents(ctx, ListAgentsOptions{Email: "ALEX.ADMIN@example.com"})
+	if err != nil {
+		t.Errorf("freshclient.ListAgents() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(agents) != 1 || agents[0].ID != created.ID {
+		t.Errorf("freshclient.ListAgents() = %+v, want only agent %d", agents, created.ID)
+	}
+
+	agents, err = client.ListAgents(ctx, ListAgentsOptions{State: AgentStateOccasional})
+	if err != nil {
+		t.Errorf("freshclient.ListAgents() error = %v, want %v", err, nil)
+	}
+	if len(agents) != 0 {
+		t.Errorf("freshclient.ListAgents() = %+v, want no occasional agents", agents)
+	}
+
+	created.JobTitle = "IT Lead"
+	created.MemberOf = []int64{}
+	updated, err := client.UpdateAgent(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.JobTitle != "IT Lead" || len(updated.MemberOf) != 0 {
+		t.Errorf("freshclient.UpdateAgent() = %+v, want the job title updated and no memberships", updated)
+	}
+
+	if err := client.DeactivateAgent(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeactivateAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	deactivated, err := client.GetAgent(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.GetAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if deactivated.Active {
+		t.Errorf("freshclient.GetAgent() = %+v, want a deactivated agent", deactivated)
+	}
+
+	inactive, err := client.ListAgents(ctx, ListAgentsOptions{Email: created.Email, Inactive: true})
+	if err != nil || len(inactive) != 1 || inactive[0].ID != created.ID {
+		t.Errorf("freshclient.ListAgents() = %+v, %v, want the deactivated agent %d", inactive, err, created.ID)
+	}
+
+	reactivated, err := client.ReactivateAgent(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.ReactivateAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if !reactivated.Active || reactivated.ID != created.ID {
+		t.Errorf("freshclient.ReactivateAgent() = %+v, want the active agent %d", reactivated, created.ID)
+	}
+
+	if err := client.ForgetAgent(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.ForgetAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if _, err := client.GetAgent(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetAgent() error = %v, want not found", err)
+	}
+}
+
+// TestAgentUnmanagedGroups tests that updating an agent without group
+// memberships keeps the memberships set on the agent groups.
+func TestAgentUnmanagedGroups(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	id := server.AddAgent("Sam", "sam@example.com")
+	groupID := server.AddGroup("TestGolang Network", id)
+
+	agent, err := client.GetAgent(ctx, id)
+	if err != nil {
+		t.Errorf("freshclient.GetAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if !reflect.DeepEqual(agent.MemberOf, []int64{groupID}) {
+		t.Errorf("freshclient.GetAgent() member of = %v, want %v", agent.MemberOf, []int64{groupID})
+	}
+
+	agent.MemberOf = nil
+	agent.Roles = []AgentRole{{RoleID: 1, AssignmentScope: AgentScopeEntireHelpdesk}}
+	updated, err := client.UpdateAgent(ctx, *agent)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAgent() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if !reflect.DeepEqual(updated.MemberOf, []int64{groupID}) {
+		t.Errorf("freshclient.UpdateAgent() member of = %v, want %v", updated.MemberOf, []int64{groupID})
+	}
+}
@@ -0,0 +1,122 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListApplications lists every software application from the FreshService API.
+func (client *Client) ListApplications(ctx context.Context) ([]ApplicationDetails, error) {
+	return ListAll[ApplicationDetails](ctx, client, *client.APIEndpoint+"/applications", "applications")
+}
+
+// CreateApplication creates a software application in the FreshService API.
+func (client *Client) CreateApplication(ctx context.Context, applicationDetails ApplicationDetails) (*ApplicationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/applications", applicationDetails.ToApplicationDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newApplication Application
+	if err := json.NewDecoder(resp.Body).Decode(&newApplication); err != nil {
+		return nil, err
+	}
+
+	return &newApplication.ApplicationDetails, nil
+}
+
+// GetApplication gets a software application from the FreshService API.
+func (client *Client) GetApplication(ctx context.Context, id int64) (*ApplicationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/applications/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var application Application
+	if err := json.NewDecoder(resp.Body).Decode(&application); err != nil {
+		return nil, err
+	}
+
+	return &application.ApplicationDetails, nil
+}
+
+// UpdateApplication updates a software application in the FreshService API.
+func (client *Client) UpdateApplication(ctx context.Context, applicationDetails ApplicationDetails) (*ApplicationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/applications/"+strconv.FormatInt(applicationDetails.ID, 10), applicationDetails.ToApplicationDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedApplication Application
+	if err := json.NewDecoder(resp.Body).Decode(&updatedApplication); err != nil {
+		return nil, err
+	}
+
+	return &updatedApplication.ApplicationDetails, nil
+}
+
+// DeleteApplication deletes a software application and its installations
+// from the FreshService API.
+func (client *Client) DeleteApplication(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/applications/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ListApplicationInstallations lists the installations of a software
+// application from the FreshService API.
+func (client *Client) ListApplicationInstallations(ctx context.Context, applicationID int64) ([]InstallationDetails, error) {
+	return ListAll[InstallationDetails](ctx, client, *client.APIEndpoint+"/applications/"+strconv.FormatInt(applicationID, 10)+"/installations", "installations")
+}
+
+// CreateApplicationInstallation records an installation of a software
+// application on an asset in the FreshService API.
+func (client *Client) CreateApplicationInstallation(ctx context.Context, applicationID int64, installationDetails InstallationDetails) (*InstallationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/applications/"+strconv.FormatInt(applicationID, 10)+"/installations", installationDetails)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var installation Installation
+	if err := json.NewDecoder(resp.Body).Decode(&installation); err != nil {
+		return nil, err
+	}
+
+	return &installation.InstallationDetails, nil
+}
+
+// DeleteApplicationInstallations removes the installations of a software
+// application from the assets with the given display IDs.
+func (client *Client) DeleteApplicationInstallations(ctx context.Context, applicationID int64, assetDisplayIDs ...int64) error {
+	values := make([]string, 0, len(assetDisplayIDs))
+	for _, id := range assetDisplayIDs {
+		values = append(values, strconv.FormatInt(id, 10))
+	}
+	query := url.Values{}
+	query.Set("device_ids", strings.Join(values, ","))
+
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/applications/"+strconv.FormatInt(applicationID, 10)+"/installations?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ListAssetApplications lists the software applications installed on an
+// asset from the FreshService API.
+func (client *Client) ListAssetApplications(ctx context.Context, assetDisplayID int64) ([]ApplicationDetails, error) {
+	return ListAll[ApplicationDetails](ctx, client, *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDisplayID, 10)+"/applications", "applications")
+}
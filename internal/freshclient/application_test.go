@@ -0,0 +1,121 @@
+package freshclient
+
+import (
+	"context"
+	"testing"
+)
+
+// TestApplicationCRUD tests creating, updating and deleting a software application.
+func TestApplicationCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateApplication(ctx, ApplicationDetails{
+		Name:            "TestGolang Editor",
+		Description:     "Created by Go",
+		ApplicationType: "desktop",
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateApplication() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if created.Status != "managed" {
+		t.Errorf("freshclient.CreateApplication() status = %q, want %q", created.Status, "managed")
+	}
+
+	created.Category = "Development"
+	updated, err := client.UpdateApplication(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateApplication() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.Category != "Development" || updated.Name != "TestGolang Editor" {
+		t.Errorf("freshclient.UpdateApplication() = %+v, want the category updated", updated)
+	}
+
+	got, err := client.GetApplication(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.GetApplication() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if got.Category != "Development" || got.Description != "Created by Go" {
+		t.Errorf("freshclient.GetApplication() = %+v, want the updated application", got)
+	}
+
+	if err := client.DeleteApplication(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteApplication() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetApplication(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetApplication() error = %v, want not found", err)
+	}
+}
+
+// TestApplicationInstallations tests recording and removing installations
+// and listing the applications of an asset.
+func TestApplicationInstallations(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	laptop := server.AddAsset(map[string]interface{}{"name": "TestGolangLaptop", "asset_type_id": 1})
+	desktop := server.AddAsset(map[string]interface{}{"name": "TestGolangDesktop", "asset_type_id": 1})
+	browser := server.AddApplication("TestGolang Browser")
+	editor := server.AddApplication("TestGolang Editor")
+	server.AddInstallation(editor, desktop, "1.0")
+
+	for _, displayID := range []int64{laptop, desktop} {
+		if _, err := client.CreateApplicationInstallation(ctx, browser, InstallationDetails{
+			InstallationMachineID: displayID,
+			InstallationPath:      "/usr/bin/browser",
+			Version:               "118.0",
+		}); err != nil {
+			t.Errorf("freshclient.CreateApplicationInstallation() error = %v, want %v", err, nil)
+			t.FailNow()
+		}
+	}
+
+	if _, err := client.CreateApplicationInstallation(ctx, browser, InstallationDetails{InstallationMachineID: laptop}); err == nil {
+		t.Errorf("freshclient.CreateApplicationInstallation() error = %v, want a duplicate installation error", err)
+	}
+
+	installations, err := client.ListApplicationInstallations(ctx, browser)
+	if err != nil {
+		t.Errorf("freshclient.ListApplicationInstallations() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(installations) != 2 || installations[0].InstallationMachineID != laptop || installations[0].Version != "118.0" {
+		t.Errorf("freshclient.ListApplicationInstallations() = %+v, want the laptop and desktop installations", installations)
+	}
+
+	applications, err := client.ListAssetApplications(ctx, desktop)
+	if err != nil {
+		t.Errorf("freshclient.ListAssetApplications() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(applications) != 2 || applications[0].ID != browser || applications[1].ID != editor {
+		t.Errorf("freshclient.ListAssetApplications() = %+v, want the browser and the editor", applications)
+	}
+
+	if err := client.DeleteApplicationInstallations(ctx, browser, desktop); err != nil {
+		t.Errorf("freshclient.DeleteApplicationInstallations() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	applications, err = client.ListAssetApplications(ctx, desktop)
+	if err != nil {
+		t.Errorf("freshclient.ListAssetApplications() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(applications) != 1 || applications[0].ID != editor {
+		t.Errorf("freshclient.ListAssetApplications() = %+v, want only the editor", applications)
+	}
+
+	browserDetails, err := client.GetApplication(ctx, browser)
+	if err != nil {
+		t.Errorf("freshclient.GetApplication() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if browserDetails.InstallationCount != 1 {
+		t.Errorf("freshclient.GetApplication() installation count = %d, want %d", browserDetails.InstallationCount, 1)
+	}
+}
@@ -1,14 +1,17 @@
 package freshclient
 
 import (
+	"context"
 	"encoding/json"
+	"net/url"
 	"strconv"
+	"strings"
 )
 
 // CreateAsset creates an asset in the FreshService API.
-func (client *Client) CreateAsset(assetDetails AssetDetails) (*AssetDetails, error) {
+func (client *Client) CreateAsset(ctx context.Context, assetDetails AssetDetails) (*AssetDetails, error) {
 	// Make the request
-	resp, err := client.MakeRequest("POST", *client.APIEndpoint+"/assets", assetDetails)
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/assets", assetDetails)
 	if err != nil {
 		return nil, err
 	}
@@ -22,10 +25,10 @@ func (client *Client) CreateAsset(assetDetails AssetDetails) (*AssetDetails, err
 	return &newAsset.AssetDetails, nil
 }
 
-// GetAsset gets an asset from the FreshService API.
-func (client *Client) GetAsset(assetDipslayID int64) (*AssetDetails, error) {
+// GetAsset gets an asset including its type fields from the FreshService API.
+func (client *Client) GetAsset(ctx context.Context, assetDipslayID int64) (*AssetDetails, error) {
 	// Make the request
-	resp, err := client.MakeRequest("GET", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDipslayID, 10), nil)
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDipslayID, 10)+"?include=type_fields", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,10 +43,10 @@ func (client *Client) GetAsset(assetDipslayID int64) (*AssetDetails, error) {
 }
 
 // UpdateAsset updates an asset in the FreshService API.
-func (client *Client) UpdateAsset(assetDetails AssetDetails) (*AssetDetails, error) {
+func (client *Client) UpdateAsset(ctx context.Context, assetDetails AssetDetails) (*AssetDetails, error) {
 
 	// Make the request
-	resp, err := client.MakeRequest("PUT", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDetails.DisplayID, 10), assetDetails.ToAssetDetailsUpdate())
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDetails.DisplayID, 10), assetDetails.ToAssetDetailsUpdate())
 	if err != nil {
 		return nil, err
 	}
@@ -57,13 +60,92 @@ func (client *Client) UpdateAsset(assetDetails AssetDetails) (*AssetDetails, err
 	return &updatedAsset.AssetDetails, nil
 }
 
-// DeleteAsset deletes an asset from the FreshService API.
-func (client *Client) DeleteAsset(assetDetails AssetDetails) error {
+// DeleteAsset moves an asset to the trash in the FreshService API.
+func (client *Client) DeleteAsset(ctx context.Context, assetDetails AssetDetails) error {
 	// Make the request
-	_, err := client.MakeRequest("DELETE", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDetails.DisplayID, 10), nil)
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDetails.DisplayID, 10), nil)
 	if err != nil {
 		return err
 	}
+	resp.Body.Close()
 
 	return nil
 }
+
+// DeleteAssetForever permanently deletes a trashed asset from the FreshService API.
+func (client *Client) DeleteAssetForever(ctx context.Context, assetDetails AssetDetails) error {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDetails.DisplayID, 10)+"/delete_forever", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// RestoreAsset restores a trashed asset in the FreshService API.
+func (client *Client) RestoreAsset(ctx context.Context, assetDetails AssetDetails) error {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDetails.DisplayID, 10)+"/restore", nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ListAssetsOptions narrows down the assets returned by ListAssets.
+type ListAssetsOptions struct {
+	// Filter is a FreshService filter query, e.g. asset_type_id:123 AND location_id:5
+	Filter string
+	// Search is a FreshService search query, e.g. name:'web-01'
+	Search string
+	// IncludeTypeFields returns the type fields of every asset
+	IncludeTypeFields bool
+	// Trashed lists the assets in the trash instead
+	Trashed bool
+}
+
+// query returns the URL query for the options.
+func (options ListAssetsOptions) query() url.Values {
+	query := url.Values{}
+	if options.Filter != "" {
+		query.Set("filter", quoteQuery(options.Filter))
+	}
+	if options.Search != "" {
+		query.Set("search", quoteQuery(options.Search))
+	}
+	if options.IncludeTypeFields {
+		query.Set("include", "type_fields")
+	}
+	if options.Trashed {
+		query.Set("trashed", "true")
+	}
+
+	return query
+}
+
+// quoteQuery wraps a filter or search query in the double quotes the API
+// expects around it.
+func quoteQuery(query string) string {
+	if strings.HasPrefix(query, `"`) && strings.HasSuffix(query, `"`) && len(query) > 1 {
+		return query
+	}
+
+	return `"` + query + `"`
+}
+
+// ListAssets lists every asset matching options from the FreshService API.
+func (client *Client) ListAssets(ctx context.Context, options ListAssetsOptions) ([]AssetDetails, error) {
+	assetsURL := *client.APIEndpoint + "/assets"
+	if query := options.query().Encode(); query != "" {
+		assetsURL += "?" + query
+	}
+
+	return ListAll[AssetDetails](ctx, client, assetsURL, "assets")
+}
+
+// ListTrashedAssets lists every asset in the trash from the FreshService API.
+func (client *Client) ListTrashedAssets(ctx context.Context) ([]AssetDetails, error) {
+	return client.ListAssets(ctx, ListAssetsOptions{Trashed: true})
+}
@@ -0,0 +1,11 @@
+package freshclient
+
+import (
+	"context"
+	"strconv"
+)
+
+// ListAssetComponents lists the components of an asset from the FreshService API.
+func (client *Client) ListAssetComponents(ctx context.Context, assetDisplayID int64) ([]AssetComponentDetails, error) {
+	return ListAll[AssetComponentDetails](ctx, client, *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDisplayID, 10)+"/components", "components")
+}
@@ -0,0 +1,33 @@
+package freshclient
+
+import (
+	"context"
+	"testing"
+)
+
+// TestListAssetComponents tests that only the components of the asset are listed.
+func TestListAssetComponents(t *testing.T) {
+	client, server := newFakeClient(t)
+	host := server.AddAsset(map[string]interface{}{"name": "TestGolangHost", "asset_type_id": 1})
+	other := server.AddAsset(map[string]interface{}{"name": "TestGolangOther", "asset_type_id": 1})
+	server.AddAssetComponent(host, "Processor", map[string]interface{}{"manufacturer": "Intel", "no_of_cores": 16})
+	server.AddAssetComponent(host, "Memory", map[string]interface{}{"capacity": "64 GB"})
+	server.AddAssetComponent(other, "Disk", map[string]interface{}{"capacity": "1 TB"})
+
+	components, err := client.ListAssetComponents(context.Background(), host)
+	if err != nil {
+		t.Errorf("freshclient.ListAssetComponents() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(components) != 2 {
+		t.Errorf("freshclient.ListAssetComponents() = %v, want 2 components", components)
+		t.FailNow()
+	}
+	if components[0].ComponentType != "Processor" || components[0].ComponentData["manufacturer"] != "Intel" {
+		t.Errorf("freshclient.ListAssetComponents() = %+v, want the processor first", components[0])
+	}
+
+	if _, err := client.ListAssetComponents(context.Background(), 999); !IsNotFound(err) {
+		t.Errorf("freshclient.ListAssetComponents() error = %v, want not found", err)
+	}
+}
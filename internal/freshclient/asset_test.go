@@ -1,34 +1,36 @@
 package freshclient
 
 import (
-	"os"
+	"context"
+	"errors"
+	"strconv"
 	"testing"
 )
 
 // TestAsset tests the UpdateAsset function.
 func TestAsset(t *testing.T) {
-	// Check if env vars are set
-	if os.Getenv("FRESHDESK_API_KEY_TEST") == "" || os.Getenv("FRESHDESK_API_ENDPOINT_TEST") == "" {
-		t.Errorf("TestAsset() error = %v, want %v", "Please set FRESHDESK_API_KEY_TEST and FRESHDESK_API_ENDPOINT_TEST", nil)
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	assetType, err := client.GetAssetType(ctx, testAssetTypeName)
+	if err != nil {
+		t.Errorf("freshclient.GetAssetType() error = %v, want %v", err, nil)
 		t.FailNow()
 	}
 
-	// Retrieve API credentials from environment variables
-	client := NewClient(os.Getenv("FRESHDESK_API_KEY_TEST"), os.Getenv("FRESHDESK_API_ENDPOINT_TEST"))
-
 	// Create an asset for testing update
 	assetDetails := AssetDetails{
 		Name:        "TestGolangAsset",
-		AssetTypeID: 50000240147,
+		AssetTypeID: assetType.ID,
 	}
 
-	createdAsset, err := client.CreateAsset(assetDetails)
+	createdAsset, err := client.CreateAsset(ctx, assetDetails)
 	if err != nil {
 		t.Errorf("freshclient.CreateAsset() error = %v, want %v", err.Error(), nil)
 		t.FailNow()
 	}
 
-	getAsset, err := client.GetAsset(createdAsset.DisplayID)
+	getAsset, err := client.GetAsset(ctx, createdAsset.DisplayID)
 	if err != nil {
 		t.Errorf("freshclient.GetAsset() error = %v, want %v", err.Error(), nil)
 		t.FailNow()
@@ -44,7 +46,7 @@ func TestAsset(t *testing.T) {
 	createdAsset.Description = "TestAssetUpdate"
 
 	// Update the asset
-	updatedAsset, err := client.UpdateAsset(*createdAsset)
+	updatedAsset, err := client.UpdateAsset(ctx, *createdAsset)
 	if err != nil {
 		t.Errorf("freshclient.UpdateAsset() error = %v, want %v", err, nil)
 		t.FailNow()
@@ -57,8 +59,156 @@ func TestAsset(t *testing.T) {
 	}
 
 	// Cleanup: Delete the asset created for testing update
-	err = client.DeleteAsset(*updatedAsset)
+	err = client.DeleteAsset(ctx, *updatedAsset)
+	if err != nil {
+		t.Errorf("freshclient.DeleteAsset() error = %v, want %v", err, nil)
+	}
+}
+
+// TestAssetValidation tests that field errors are returned for an invalid asset.
+func TestAssetValidation(t *testing.T) {
+	client, _ := newFakeClient(t)
+
+	_, err := client.CreateAsset(context.Background(), AssetDetails{Name: "TestGolangAsset", AssetTypeID: 1, Impact: "huge"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != "impact" {
+		t.Errorf("freshclient.CreateAsset() error = %v, want %v", err, "impact validation error")
+	}
+}
+
+// TestAssetRetry tests that rate limited and failing requests succeed after retrying.
+func TestAssetRetry(t *testing.T) {
+	client, server := newFakeClient(t)
+	displayID := server.AddAsset(map[string]interface{}{"name": "TestGolangAsset", "asset_type_id": 1})
+
+	server.RateLimitNext(1, 0)
+	server.FailNext(500, 1)
+
+	asset, err := client.GetAsset(context.Background(), displayID)
+	if err != nil {
+		t.Errorf("freshclient.GetAsset() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if asset.Name != "TestGolangAsset" || server.Requests() != 3 {
+		t.Errorf("freshclient.GetAsset() = %v in %d requests, want %v", asset.Name, server.Requests(), "TestGolangAsset in 3 requests")
+	}
+}
+
+// TestListAssets tests that every page of assets is returned.
+func TestListAssets(t *testing.T) {
+	client, server := newFakeClient(t)
+	for i := 0; i < 150; i++ {
+		server.AddAsset(map[string]interface{}{"name": "TestGolangAsset", "asset_type_id": 1})
+	}
+
+	assets, err := client.ListAssets(context.Background(), ListAssetsOptions{})
+	if err != nil {
+		t.Errorf("freshclient.ListAssets() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if len(assets) != 150 {
+		t.Errorf("freshclient.ListAssets() = %v assets, want %v", len(assets), 150)
+	}
+}
+
+// TestAssetNotFound tests that deleted and trashed assets are reported as not found.
+func TestAssetNotFound(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	trashedID := server.AddAsset(map[string]interface{}{"name": "TestGolangAsset", "asset_type_id": 1})
+
+	if err := client.DeleteAsset(ctx, AssetDetails{DisplayID: trashedID}); err != nil {
+		t.Errorf("freshclient.DeleteAsset() error = %v, want %v", err, nil)
+	}
+
+	for _, displayID := range []int64{trashedID, 404} {
+		if _, err := client.GetAsset(ctx, displayID); !IsNotFound(err) {
+			t.Errorf("freshclient.GetAsset(%d) error = %v, want %v", displayID, err, ErrResourceNotFound)
+		}
+	}
+
+	if err := client.DeleteAsset(ctx, AssetDetails{DisplayID: 404}); !IsNotFound(err) {
+		t.Errorf("freshclient.DeleteAsset() error = %v, want %v", err, ErrResourceNotFound)
+	}
+}
+
+// TestAssetTrash tests moving an asset to the trash, restoring and purging it.
+func TestAssetTrash(t *testing.T) {
+	client, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	asset, err := client.CreateAsset(ctx, AssetDetails{Name: "TestGolangAsset", AssetTypeID: 1})
 	if err != nil {
+		t.Errorf("freshclient.CreateAsset() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if err := client.DeleteAsset(ctx, *asset); err != nil {
 		t.Errorf("freshclient.DeleteAsset() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	trashed, err := client.ListTrashedAssets(ctx)
+	if err != nil || len(trashed) != 1 || trashed[0].DisplayID != asset.DisplayID {
+		t.Errorf("freshclient.ListTrashedAssets() = %v, %v, want %v", trashed, err, asset.DisplayID)
+	}
+
+	if err := client.RestoreAsset(ctx, *asset); err != nil {
+		t.Errorf("freshclient.RestoreAsset() error = %v, want %v", err, nil)
+	}
+
+	if _, err := client.GetAsset(ctx, asset.DisplayID); err != nil {
+		t.Errorf("freshclient.GetAsset() error = %v, want %v", err, nil)
+	}
+
+	// Only trashed assets can be deleted forever
+	if err := client.DeleteAssetForever(ctx, *asset); !IsNotFound(err) {
+		t.Errorf("freshclient.DeleteAssetForever() error = %v, want %v", err, ErrResourceNotFound)
+	}
+
+	if err := client.DeleteAsset(ctx, *asset); err != nil {
+		t.Errorf("freshclient.DeleteAsset() error = %v, want %v", err, nil)
+	}
+
+	if err := client.DeleteAssetForever(ctx, *asset); err != nil {
+		t.Errorf("freshclient.DeleteAssetForever() error = %v, want %v", err, nil)
+	}
+
+	if err := client.RestoreAsset(ctx, *asset); !IsNotFound(err) {
+		t.Errorf("freshclient.RestoreAsset() error = %v, want %v", err, ErrResourceNotFound)
+	}
+}
+
+// TestListAssetsQuery tests listing assets with filter and search queries.
+func TestListAssetsQuery(t *testing.T) {
+	client, server := newFakeClient(t)
+	laptopTypeID := server.AddAssetType("Laptop", 0)
+	server.AddAsset(map[string]interface{}{"name": "web-01", "asset_type_id": 1, "location_id": 5})
+	server.AddAsset(map[string]interface{}{"name": "web-02", "asset_type_id": laptopTypeID, "location_id": 5})
+	server.AddAsset(map[string]interface{}{"name": "db-01", "asset_type_id": laptopTypeID, "location_id": 6})
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		options ListAssetsOptions
+		want    int
+	}{
+		"filter":     {ListAssetsOptions{Filter: "asset_type_id:" + strconv.FormatInt(laptopTypeID, 10) + " AND location_id:5"}, 1},
+		"filter or":  {ListAssetsOptions{Filter: "location_id:5 OR location_id:6"}, 3},
+		"search":     {ListAssetsOptions{Search: "name:'web-'"}, 2},
+		"no matches": {ListAssetsOptions{Search: "name:'mail'"}, 0},
+	}
+
+	for name, test := range tests {
+		assets, err := client.ListAssets(ctx, test.options)
+		if err != nil {
+			t.Errorf("freshclient.ListAssets() %s error = %v, want %v", name, err, nil)
+			continue
+		}
+		if len(assets) != test.want {
+			t.Errorf("freshclient.ListAssets() %s = %v assets, want %v", name, len(assets), test.want)
+		}
 	}
 }
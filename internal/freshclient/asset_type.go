@@ -1,27 +1,125 @@
 package freshclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 )
 
 // GetAssetType gets an asset type from the FreshService API.
-func (client *Client) GetAssetType(name string) (*AssetTypeDetails, error) {
-	resp, err := client.MakeRequest("GET", *client.APIEndpoint+"/asset_types/?per_page=600", nil)
+func (client *Client) GetAssetType(ctx context.Context, name string) (*AssetTypeDetails, error) {
+	var found *AssetTypeDetails
+	err := ListPages(ctx, client, *client.APIEndpoint+"/asset_types", "asset_types", func(assetTypes []AssetTypeDetails) error {
+		for _, assetType := range assetTypes {
+			if assetType.Name == name {
+				assetType := assetType
+				found = &assetType
+				return ErrStopPagination
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("asset type %s not found", name)
+	}
+
+	return found, nil
+}
+
+// ListAssetTypes lists every asset type from the FreshService API.
+func (client *Client) ListAssetTypes(ctx context.Context) ([]AssetTypeDetails, error) {
+	return ListAll[AssetTypeDetails](ctx, client, *client.APIEndpoint+"/asset_types", "asset_types")
+}
+
+// GetAssetTypeByID gets an asset type by its ID from the FreshService API.
+func (client *Client) GetAssetTypeByID(ctx context.Context, id int64) (*AssetTypeDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/asset_types/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var assetType AssetType
+	if err := json.NewDecoder(resp.Body).Decode(&assetType); err != nil {
+		return nil, err
+	}
+
+	return &assetType.AssetTypeDetails, nil
+}
+
+// CreateAssetType creates an asset type in the FreshService API.
+func (client *Client) CreateAssetType(ctx context.Context, assetTypeDetails AssetTypeDetails) (*AssetTypeDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/asset_types", assetTypeDetails.ToAssetTypeDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newAssetType AssetType
+	if err := json.NewDecoder(resp.Body).Decode(&newAssetType); err != nil {
+		return nil, err
+	}
+
+	return &newAssetType.AssetTypeDetails, nil
+}
+
+// UpdateAssetType updates an asset type in the FreshService API, the parent
+// of an asset type cannot be changed and is left out of the request.
+func (client *Client) UpdateAssetType(ctx context.Context, assetTypeDetails AssetTypeDetails) (*AssetTypeDetails, error) {
+	update := assetTypeDetails.ToAssetTypeDetailsUpdate()
+	update.ParentAssetTypeID = 0
+
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/asset_types/"+strconv.FormatInt(assetTypeDetails.ID, 10), update)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedAssetType AssetType
+	if err := json.NewDecoder(resp.Body).Decode(&updatedAssetType); err != nil {
+		return nil, err
+	}
+
+	return &updatedAssetType.AssetTypeDetails, nil
+}
+
+// DeleteAssetType deletes an asset type from the FreshService API.
+func (client *Client) DeleteAssetType(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/asset_types/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// GetAssetTypeFields gets the fields of an asset type from the FreshService
+// API, including default fields and fields inherited from parent asset types.
+func (client *Client) GetAssetTypeFields(ctx context.Context, id int64) ([]AssetTypeFieldDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/asset_types/"+strconv.FormatInt(id, 10)+"/fields", nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
-	var assetTypes AssetTypes
-	if err := json.NewDecoder(resp.Body).Decode(&assetTypes); err != nil {
+
+	var assetTypeFields AssetTypeFields
+	if err := json.NewDecoder(resp.Body).Decode(&assetTypeFields); err != nil {
 		return nil, err
 	}
 
-	for _, assetType := range assetTypes.AssetTypes {
-		if assetType.Name == name {
-			return &assetType, nil
+	var fields []AssetTypeFieldDetails
+	for _, group := range assetTypeFields.AssetTypeFields {
+		for _, field := range group.Fields {
+			field.FieldHeader = group.FieldHeader
+			fields = append(fields, field)
 		}
 	}
 
-	return nil, fmt.Errorf("asset type %s not found", name)
+	return fields, nil
 }
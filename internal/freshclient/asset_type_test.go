@@ -1,27 +1,145 @@
 package freshclient
 
 import (
-	"os"
+	"context"
+	"errors"
+	"reflect"
 	"testing"
 )
 
 func TestGetAssetType(t *testing.T) {
-	// Check if env vars are set
-	if os.Getenv("FRESHDESK_API_KEY_TEST") == "" || os.Getenv("FRESHDESK_API_ENDPOINT_TEST") == "" {
-		t.Errorf("TestAsset() error = %v, want %v", "Please set FRESHDESK_API_KEY_TEST and FRESHDESK_API_ENDPOINT_TEST", nil)
+	client := newTestClient(t)
+
+	got, err := client.GetAssetType(context.Background(), testAssetTypeName)
+	if err != nil {
+		t.Errorf("freshclient.GetAssetType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if got == nil {
+		t.Errorf("freshclient.GetAssetType() = %v, want %v", got, nil)
 		t.FailNow()
 	}
+}
+
+// TestGetAssetTypeNotFound tests that a missing asset type is reported.
+func TestGetAssetTypeNotFound(t *testing.T) {
+	client, server := newFakeClient(t)
+	for i := 0; i < 120; i++ {
+		server.AddAssetType("Filler", 0)
+	}
+	server.AddAssetType("Last", 0)
+
+	if _, err := client.GetAssetType(context.Background(), "Last"); err != nil {
+		t.Errorf("freshclient.GetAssetType() error = %v, want %v", err, nil)
+	}
+
+	if _, err := client.GetAssetType(context.Background(), "Missing"); err == nil {
+		t.Errorf("freshclient.GetAssetType() error = %v, want %v", err, "not found")
+	}
+}
 
-	// Retrieve API credentials from environment variables
-	client := NewClient(os.Getenv("FRESHDESK_API_KEY_TEST"), os.Getenv("FRESHDESK_API_ENDPOINT_TEST"))
+// TestAssetTypeCRUD tests creating, updating and deleting an asset type.
+func TestAssetTypeCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
 
-	got, err := client.GetAssetType("VMware VCenter VM")
+	parent, err := client.GetAssetType(ctx, testAssetTypeName)
 	if err != nil {
 		t.Errorf("freshclient.GetAssetType() error = %v, want %v", err, nil)
 		t.FailNow()
 	}
-	if got == nil {
-		t.Errorf("freshclient.GetAssetType() = %v, want %v", got, nil)
+
+	created, err := client.CreateAssetType(ctx, AssetTypeDetails{
+		Name:              "TestGolangAssetType",
+		Description:       "Created by Go",
+		ParentAssetTypeID: parent.ID,
+		Visible:           true,
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateAssetType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if created.ParentAssetTypeID != parent.ID {
+		t.Errorf("freshclient.CreateAssetType() parent = %v, want %v", created.ParentAssetTypeID, parent.ID)
+	}
+
+	created.Description = "Updated by Go"
+	created.Visible = false
+	updated, err := client.UpdateAssetType(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateAssetType() error = %v, want %v", err, nil)
 		t.FailNow()
 	}
+	if updated.Description != "Updated by Go" || updated.Visible {
+		t.Errorf("freshclient.UpdateAssetType() = %+v, want description and visible updated", updated)
+	}
+
+	got, err := client.GetAssetTypeByID(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.GetAssetTypeByID() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if got.Name != "TestGolangAssetType" || got.Description != "Updated by Go" {
+		t.Errorf("freshclient.GetAssetTypeByID() = %+v, want the updated asset type", got)
+	}
+
+	if err := client.DeleteAssetType(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteAssetType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetAssetTypeByID(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetAssetTypeByID() error = %v, want not found", err)
+	}
+}
+
+// TestAssetTypeDuplicateName tests that asset type names must be unique
+// among siblings.
+func TestAssetTypeDuplicateName(t *testing.T) {
+	client, _ := newFakeClient(t)
+
+	_, err := client.CreateAssetType(context.Background(), AssetTypeDetails{Name: testAssetTypeName, Visible: true})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) != 1 || apiErr.Errors[0].Code != "duplicate_value" {
+		t.Errorf("freshclient.CreateAssetType() error = %v, want %v", err, "duplicate_value error")
+	}
+}
+
+// TestGetAssetTypeFields tests that type fields of an asset type and its
+// parents are listed and resolve inherited field keys.
+func TestGetAssetTypeFields(t *testing.T) {
+	client, server := newFakeClient(t)
+	childID := server.AddAssetType("TestGolangAssetType", 1)
+	serialNumber := server.AddAssetTypeField(1, "serial_number", "Serial Number", "text")
+	environment := server.AddAssetTypeField(childID, "environment", "Environment", "text")
+	server.SetAssetTypeFieldChoices(childID, environment, "production", "staging")
+
+	fields, err := client.GetAssetTypeFields(context.Background(), childID)
+	if err != nil {
+		t.Errorf("freshclient.GetAssetTypeFields() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	typeFields := map[string]AssetTypeFieldDetails{}
+	for _, field := range fields {
+		if field.IsTypeField() {
+			typeFields[field.Name] = field
+		}
+	}
+	if len(typeFields) != 2 || len(fields) <= 2 {
+		t.Errorf("freshclient.GetAssetTypeFields() = %+v, want default fields and 2 type fields", fields)
+		t.FailNow()
+	}
+	if got := typeFields[serialNumber]; got.FieldHeader != testAssetTypeName || got.Label != "Serial Number" {
+		t.Errorf("freshclient.GetAssetTypeFields() inherited field = %+v, want header %v", got, testAssetTypeName)
+	}
+	if got := typeFields[environment].ChoiceValues(); !reflect.DeepEqual(got, []string{"production", "staging"}) {
+		t.Errorf("AssetTypeFieldDetails.ChoiceValues() = %v, want %v", got, []string{"production", "staging"})
+	}
+
+	got := ExpandTypeFields(map[string]interface{}{"serial_number": "SN-1"}, childID, KnownTypeFields(fields))
+	if _, ok := got[serialNumber]; !ok {
+		t.Errorf("freshclient.ExpandTypeFields() = %v, want key %v", got, serialNumber)
+	}
 }
@@ -2,9 +2,13 @@ package freshclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	http "net/http"
+	"time"
 )
 
 type Client struct {
@@ -14,6 +18,12 @@ type Client struct {
 	APIKey *string
 	// The API endpoint to use for requests
 	APIEndpoint *string
+	// The maximum number of attempts for a single request, including the first one
+	MaxAttempts int
+	// The maximum time to wait before retrying a request
+	MaxRetryWait time.Duration
+	// The time to wait between polls of a background job
+	JobPollInterval time.Duration
 }
 
 // NewClient creates a new FreshClient.
@@ -24,60 +34,159 @@ func NewClient(apiKey string, apiEndpoint string) *Client {
 	}
 
 	return &Client{
-		HTTPClient:  http.DefaultClient,
-		APIKey:      &apiKey,
-		APIEndpoint: &apiEndpoint,
+		HTTPClient:      http.DefaultClient,
+		APIKey:          &apiKey,
+		APIEndpoint:     &apiEndpoint,
+		MaxAttempts:     DefaultMaxAttempts,
+		MaxRetryWait:    DefaultMaxRetryWait,
+		JobPollInterval: DefaultJobPollInterval,
 	}
 }
 
+// newRequest builds a request for a single attempt, the body is replayed
+// from the marshalled payload on every call.
+func (client *Client) newRequest(ctx context.Context, method string, url string, payload []byte) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the API key to the request using basic auth
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(*client.APIKey, "x")
+
+	return req, nil
+}
+
 // MakeRequest makes a request to the FreshService API.
-func (client *Client) MakeRequest(method string, url string, body interface{}) (*http.Response, error) {
-	req := &http.Request{}
+// Rate limited requests are retried after the Retry-After delay, capped at
+// MaxRetryWait. Server errors and network failures of idempotent requests are
+// retried with an exponential backoff.
+// Cancelling ctx aborts the request in flight as well as any pending retry.
+func (client *Client) MakeRequest(ctx context.Context, method string, url string, body interface{}) (*http.Response, error) {
+	var payload []byte
 	if body != nil {
 		marshalledBody, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
+		payload = marshalledBody
+	}
+
+	maxAttempts := client.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
 
-		// Create a new request
-		req, err = http.NewRequest(method, url, bytes.NewReader(marshalledBody))
+	for attempt := 1; ; attempt++ {
+		req, err := client.newRequest(ctx, method, url, payload)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		err := error(nil)
-		req, err = http.NewRequest(method, url, nil)
+
+		// Make the request
+		resp, err := client.HTTPClient.Do(req)
+
 		if err != nil {
-			return nil, err
+			if attempt >= maxAttempts || ctx.Err() != nil || !idempotent(method) {
+				return nil, err
+			}
+			if err := sleep(ctx, client.backoff(attempt)); err != nil {
+				return nil, err
+			}
+			continue
 		}
-	}
 
-	// Add the API key to the request using basic auth
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(*client.APIKey, "x")
+		if attempt < maxAttempts && retryableStatus(method, resp.StatusCode) {
+			wait, ok := retryAfter(resp)
+			if !ok {
+				wait = client.backoff(attempt)
+			}
 
-	// Make the request
-	resp, err := client.HTTPClient.Do(req)
+			// Try again early instead of waiting longer than allowed
+			if wait > client.MaxRetryWait {
+				wait = client.MaxRetryWait
+			}
 
-	if err != nil {
-		return nil, err
-	}
+			discardBody(resp)
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-	// Check for errors
-	if _, errorExist := ErrorMessages[resp.StatusCode]; errorExist {
-		return nil, NewErrorByCode(resp.StatusCode, ErrorMessages[resp.StatusCode])
-	}
+		// Check for errors
+		if resp.StatusCode >= 400 {
+			return nil, parseAPIError(resp)
+		}
 
-	return resp, nil
+		return resp, nil
+	}
 }
 
 // Error handeling
 // APIError represents an error in the API with additional details.
 type APIError struct {
-	Code        int    // HTTP status code
-	Text        string // Textual description
-	Description string // Additional details about the error
+	Code        int          // HTTP status code
+	Text        string       // Textual description
+	Description string       // Additional details about the error
+	Errors      []FieldError // Errors on individual fields of the request
+}
+
+// FieldError represents an error on a single field returned by the API
+// JSON Example:
+/*
+{
+    "description": "Validation failed",
+    "errors": [
+        {
+            "field": "impact",
+            "message": "It should be one of these values: 'low,medium,high'",
+            "code": "invalid_value"
+        }
+    ]
+}.
+*/
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// maxErrorBodySize limits how much of an error response is read.
+const maxErrorBodySize = 1 << 20
+
+// parseAPIError builds an APIError from an error response, the body is
+// consumed and closed.
+func parseAPIError(resp *http.Response) *APIError {
+	defer discardBody(resp)
+
+	apiErr := NewErrorByCode(resp.StatusCode, ErrorMessages[resp.StatusCode])
+
+	var body struct {
+		Description string       `json:"description"`
+		Message     string       `json:"message"`
+		Errors      []FieldError `json:"errors"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxErrorBodySize)).Decode(&body); err != nil {
+		return apiErr
+	}
+
+	switch {
+	case body.Description != "":
+		apiErr.Description = body.Description
+	case body.Message != "":
+		apiErr.Description = body.Message
+	}
+	apiErr.Errors = body.Errors
+
+	return apiErr
 }
 
 // NewAPIError creates a new APIError instance.
@@ -91,7 +200,15 @@ func NewAPIError(code int, text, description string) *APIError {
 
 // Error implements the error interface for APIError.
 func (e *APIError) Error() string {
-	return fmt.Sprintf("HTTP %d - %s: %s", e.Code, e.Text, e.Description)
+	message := fmt.Sprintf("HTTP %d - %s: %s", e.Code, e.Text, e.Description)
+	for _, fieldErr := range e.Errors {
+		if fieldErr.Field == "" {
+			message += "\n" + fieldErr.Message
+			continue
+		}
+		message += fmt.Sprintf("\n%s: %s", fieldErr.Field, fieldErr.Message)
+	}
+	return message
 }
 
 // ErrorCode constants for common API errors.
@@ -106,6 +223,9 @@ const (
 	ErrUnsupportedContentType  = 415
 	ErrRateLimitExceeded       = 429
 	ErrUnexpectedServerError   = 500
+	ErrBadGateway              = 502
+	ErrServiceUnavailable      = 503
+	ErrGatewayTimeout          = 504
 )
 
 // ErrorMessages map error codes to their respective error messages.
@@ -120,6 +240,16 @@ var ErrorMessages = map[int]string{
 	ErrUnsupportedContentType:  "Unsupported Content-type",
 	ErrRateLimitExceeded:       "Rate Limit Exceeded",
 	ErrUnexpectedServerError:   "Unexpected Server Error",
+	ErrBadGateway:              "Bad Gateway",
+	ErrServiceUnavailable:      "Service Unavailable",
+	ErrGatewayTimeout:          "Gateway Timeout",
+}
+
+// IsNotFound reports whether err is an APIError for a resource that does not
+// exist, which includes assets that were moved to the trash.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == ErrResourceNotFound
 }
 
 // NewErrorByCode creates a new APIError based on the provided error code.
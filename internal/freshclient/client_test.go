@@ -0,0 +1,95 @@
+package freshclient
+
+import (
+	"context"
+	"errors"
+	http "net/http"
+	"net/http/httptest"
+	"os"
+	"terraform-provider-fresh/internal/freshtest"
+	"testing"
+	"time"
+)
+
+// testAssetTypeName is an asset type that exists in the test tenant.
+const testAssetTypeName = "VMware VCenter VM"
+
+// newTestClient returns a client for the tenant in FRESHDESK_API_KEY_TEST and
+// FRESHDESK_API_ENDPOINT_TEST when both are set, otherwise for a fake API.
+func newTestClient(t *testing.T) *Client {
+	if os.Getenv("FRESHDESK_API_KEY_TEST") != "" && os.Getenv("FRESHDESK_API_ENDPOINT_TEST") != "" {
+		return NewClient(os.Getenv("FRESHDESK_API_KEY_TEST"), os.Getenv("FRESHDESK_API_ENDPOINT_TEST"))
+	}
+
+	client, _ := newFakeClient(t)
+	return client
+}
+
+// newFakeClient returns a client for a fake API seeded with the test asset type.
+func newFakeClient(t *testing.T) (*Client, *freshtest.Server) {
+	server := freshtest.NewServer(t)
+	server.AddAssetType(testAssetTypeName, 0)
+
+	client := NewClient(freshtest.APIKey, server.Endpoint())
+	client.MaxRetryWait = 10 * time.Millisecond
+	client.JobPollInterval = 10 * time.Millisecond
+
+	return client, server
+}
+
+// TestMakeRequestAPIError tests that error bodies are parsed into the APIError.
+func TestMakeRequestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"description":"Validation failed","errors":[{"field":"impact","message":"It should be one of these values: 'low,medium,high'","code":"invalid_value"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+
+	_, err := client.MakeRequest(context.Background(), "POST", server.URL+"/assets", AssetDetails{Impact: "huge"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, "*APIError")
+		t.FailNow()
+	}
+
+	if apiErr.Code != ErrClientValidation || apiErr.Description != "Validation failed" {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", apiErr, "HTTP 400 - Validation failed")
+	}
+
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != "impact" || apiErr.Errors[0].Code != "invalid_value" {
+		t.Errorf("freshclient.MakeRequest() errors = %v, want %v", apiErr.Errors, "impact invalid_value")
+	}
+}
+
+// TestMakeRequestAPIErrorWithoutBody tests that errors without a JSON body keep the default text.
+func TestMakeRequestAPIErrorWithoutBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+
+	_, err := client.MakeRequest(context.Background(), "GET", server.URL+"/assets/1", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ErrResourceNotFound || apiErr.Description != ErrorMessages[ErrResourceNotFound] {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, ErrResourceNotFound)
+	}
+}
+
+// TestMakeRequestAuthentication tests that a wrong API key is reported.
+func TestMakeRequestAuthentication(t *testing.T) {
+	_, server := newFakeClient(t)
+	client := NewClient("wrong-key", server.Endpoint())
+
+	_, err := client.GetAssetType(context.Background(), testAssetTypeName)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ErrAuthenticationFailure {
+		t.Errorf("freshclient.GetAssetType() error = %v, want %v", err, ErrAuthenticationFailure)
+	}
+}
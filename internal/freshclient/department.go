@@ -0,0 +1,71 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// ListDepartments lists every department from the FreshService API.
+func (client *Client) ListDepartments(ctx context.Context) ([]DepartmentDetails, error) {
+	return ListAll[DepartmentDetails](ctx, client, *client.APIEndpoint+"/departments", "departments")
+}
+
+// CreateDepartment creates a department in the FreshService API.
+func (client *Client) CreateDepartment(ctx context.Context, departmentDetails DepartmentDetails) (*DepartmentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/departments", departmentDetails.ToDepartmentDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newDepartment Department
+	if err := json.NewDecoder(resp.Body).Decode(&newDepartment); err != nil {
+		return nil, err
+	}
+
+	return &newDepartment.DepartmentDetails, nil
+}
+
+// GetDepartment gets a department from the FreshService API.
+func (client *Client) GetDepartment(ctx context.Context, id int64) (*DepartmentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/departments/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var department Department
+	if err := json.NewDecoder(resp.Body).Decode(&department); err != nil {
+		return nil, err
+	}
+
+	return &department.DepartmentDetails, nil
+}
+
+// UpdateDepartment updates a department in the FreshService API.
+func (client *Client) UpdateDepartment(ctx context.Context, departmentDetails DepartmentDetails) (*DepartmentDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/departments/"+strconv.FormatInt(departmentDetails.ID, 10), departmentDetails.ToDepartmentDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedDepartment Department
+	if err := json.NewDecoder(resp.Body).Decode(&updatedDepartment); err != nil {
+		return nil, err
+	}
+
+	return &updatedDepartment.DepartmentDetails, nil
+}
+
+// DeleteDepartment deletes a department from the FreshService API.
+func (client *Client) DeleteDepartment(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/departments/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package freshclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// TestDepartmentCRUD tests creating, updating and deleting a department.
+func TestDepartmentCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateDepartment(ctx, DepartmentDetails{
+		Name:        "TestGolang Finance",
+		Description: "Created by Go",
+		Domains:     []string{"finance.example.com"},
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateDepartment() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.CreateDepartment(ctx, DepartmentDetails{Name: "TestGolang Finance"}); err == nil {
+		t.Errorf("freshclient.CreateDepartment() error = %v, want a duplicate name error", err)
+	}
+
+	created.Domains = nil
+	created.Description = "Updated by Go"
+	updated, err := client.UpdateDepartment(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateDepartment() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.Description != "Updated by Go" || len(updated.Domains) != 0 {
+		t.Errorf("freshclient.UpdateDepartment() = %+v, want the description updated and the domains cleared", updated)
+	}
+
+	departments, err := client.ListDepartments(ctx)
+	if err != nil {
+		t.Errorf("freshclient.ListDepartments() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	found := false
+	for _, department := range departments {
+		found = found || department.ID == created.ID
+	}
+	if !found {
+		t.Errorf("freshclient.ListDepartments() = %+v, want department %d listed", departments, created.ID)
+	}
+
+	if err := client.DeleteDepartment(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteDepartment() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetDepartment(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetDepartment() error = %v, want not found", err)
+	}
+}
+
+// TestDepartmentCustomFields tests that custom fields are updated one by one.
+func TestDepartmentCustomFields(t *testing.T) {
+	client, _ := newFakeClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateDepartment(ctx, DepartmentDetails{
+		Name:         "TestGolang Payroll",
+		CustomFields: map[string]interface{}{"cost_center": "CC-100", "floor": "3"},
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateDepartment() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	created.CustomFields = map[string]interface{}{"floor": "4"}
+	if _, err := client.UpdateDepartment(ctx, *created); err != nil {
+		t.Errorf("freshclient.UpdateDepartment() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	got, err := client.GetDepartment(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.GetDepartment() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	want := map[string]interface{}{"cost_center": "CC-100", "floor": "4"}
+	if !reflect.DeepEqual(got.CustomFields, want) {
+		t.Errorf("freshclient.GetDepartment() custom fields = %v, want %v", got.CustomFields, want)
+	}
+}
@@ -0,0 +1,57 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// DefaultJobPollInterval is the time NewClient waits between polls of a
+// background job.
+const DefaultJobPollInterval = 1 * time.Second
+
+// Statuses of a background job.
+const (
+	JobStatusQueued     = "queued"
+	JobStatusInProgress = "in progress"
+	JobStatusSuccess    = "success"
+	JobStatusFailed     = "failed"
+	JobStatusPartial    = "partial"
+)
+
+// GetJob gets a background job from the FreshService API.
+func (client *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/jobs/"+jobID, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var job Job
+	if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// WaitForJob polls a background job until it is finished, the wait is only
+// bounded by ctx.
+func (client *Client) WaitForJob(ctx context.Context, jobID string) (*Job, error) {
+	for {
+		job, err := client.GetJob(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case JobStatusQueued, JobStatusInProgress:
+		default:
+			return job, nil
+		}
+
+		if err := sleep(ctx, client.JobPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// ListLocations lists every location from the FreshService API.
+func (client *Client) ListLocations(ctx context.Context) ([]LocationDetails, error) {
+	return ListAll[LocationDetails](ctx, client, *client.APIEndpoint+"/locations", "locations")
+}
+
+// CreateLocation creates a location in the FreshService API.
+func (client *Client) CreateLocation(ctx context.Context, locationDetails LocationDetails) (*LocationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/locations", locationDetails.ToLocationDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newLocation Location
+	if err := json.NewDecoder(resp.Body).Decode(&newLocation); err != nil {
+		return nil, err
+	}
+
+	return &newLocation.LocationDetails, nil
+}
+
+// GetLocation gets a location from the FreshService API.
+func (client *Client) GetLocation(ctx context.Context, id int64) (*LocationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/locations/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var location Location
+	if err := json.NewDecoder(resp.Body).Decode(&location); err != nil {
+		return nil, err
+	}
+
+	return &location.LocationDetails, nil
+}
+
+// UpdateLocation updates a location in the FreshService API.
+func (client *Client) UpdateLocation(ctx context.Context, locationDetails LocationDetails) (*LocationDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/locations/"+strconv.FormatInt(locationDetails.ID, 10), locationDetails.ToLocationDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedLocation Location
+	if err := json.NewDecoder(resp.Body).Decode(&updatedLocation); err != nil {
+		return nil, err
+	}
+
+	return &updatedLocation.LocationDetails, nil
+}
+
+// DeleteLocation deletes a location from the FreshService API.
+func (client *Client) DeleteLocation(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/locations/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
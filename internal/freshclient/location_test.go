@@ -0,0 +1,88 @@
+package freshclient
+
+import (
+	"context"
+	"testing"
+)
+
+// TestLocationCRUD tests creating, moving and deleting locations.
+func TestLocationCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	parent, err := client.CreateLocation(ctx, LocationDetails{Name: "TestGolang Berlin"})
+	if err != nil {
+		t.Errorf("freshclient.CreateLocation() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	defer func() {
+		if err := client.DeleteLocation(ctx, parent.ID); err != nil {
+			t.Errorf("freshclient.DeleteLocation() error = %v, want %v", err, nil)
+		}
+	}()
+
+	created, err := client.CreateLocation(ctx, LocationDetails{
+		Name:             "TestGolang Office 3",
+		ParentLocationID: parent.ID,
+		Address: LocationAddress{
+			Line1:   "Friedrichstraße 123",
+			City:    "Berlin",
+			Country: "Germany",
+			Zipcode: "10117",
+		},
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateLocation() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if created.ParentLocationID != parent.ID || created.Address.City != "Berlin" {
+		t.Errorf("freshclient.CreateLocation() = %+v, want a location in Berlin below %d", created, parent.ID)
+	}
+
+	if _, err := client.CreateLocation(ctx, LocationDetails{Name: "TestGolang Office 3", ParentLocationID: parent.ID}); err == nil {
+		t.Errorf("freshclient.CreateLocation() error = %v, want a duplicate name error", err)
+	}
+
+	created.ParentLocationID = 0
+	created.Address.Line2 = "3rd floor"
+	updated, err := client.UpdateLocation(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateLocation() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.ParentLocationID != 0 || updated.Address.Line2 != "3rd floor" || updated.Address.Line1 != "Friedrichstraße 123" {
+		t.Errorf("freshclient.UpdateLocation() = %+v, want a top level location with the second address line", updated)
+	}
+
+	if err := client.DeleteLocation(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteLocation() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetLocation(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetLocation() error = %v, want not found", err)
+	}
+}
+
+// TestLocationCycle tests that a location cannot be moved below its children.
+func TestLocationCycle(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	eu := server.AddLocation("TestGolang EU", 0)
+	berlin := server.AddLocation("TestGolang Berlin", eu)
+
+	location, err := client.GetLocation(ctx, eu)
+	if err != nil {
+		t.Errorf("freshclient.GetLocation() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	location.ParentLocationID = berlin
+	if _, err := client.UpdateLocation(ctx, *location); err == nil {
+		t.Errorf("freshclient.UpdateLocation() error = %v, want an invalid parent error", err)
+	}
+
+	if err := client.DeleteLocation(ctx, eu); err == nil {
+		t.Errorf("freshclient.DeleteLocation() error = %v, want an error deleting a location with children", err)
+	}
+}
@@ -1,5 +1,11 @@
 package freshclient
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // Asset represents a FreshService asset
 // asset.
 type Asset struct {
@@ -26,7 +32,8 @@ type Asset struct {
 // name
 // updated_at
 // usage_type
-// user_id.
+// user_id
+// type_fields.
 type AssetDetails struct {
 	AgentID      int64  `json:"agent_id,omitempty"`
 	AssetTag     string `json:"asset_tag,omitempty"`
@@ -46,6 +53,9 @@ type AssetDetails struct {
 	UpdatedAt    string `json:"updated_at,omitempty"`
 	UsageType    string `json:"usage_type,omitempty"`
 	UserID       int64  `json:"user_id,omitempty"`
+	// TypeFields holds the custom fields of the asset type, keyed by field
+	// name with the ID of the asset type defining the field as suffix.
+	TypeFields map[string]interface{} `json:"type_fields,omitempty"`
 }
 
 // ToAssetDetailsUpdate converts an AssetDetails to AssetDetailsUpdate.
@@ -63,6 +73,7 @@ func (details AssetDetails) ToAssetDetailsUpdate() AssetDetailsUpdate {
 		Name:         details.Name,
 		UsageType:    details.UsageType,
 		UserID:       details.UserID,
+		TypeFields:   details.TypeFields,
 	}
 }
 
@@ -80,6 +91,8 @@ type AssetDetailsUpdate struct {
 	Name             string `json:"name"`
 	UsageType        string `json:"usage_type,omitempty"`
 	UserID           int64  `json:"user_id,omitempty"`
+	// TypeFields holds the custom fields to change, keyed like AssetDetails.TypeFields
+	TypeFields map[string]interface{} `json:"type_fields,omitempty"`
 }
 
 type User struct {
@@ -114,6 +127,26 @@ type AssetTypeDetails struct {
 	Visible           bool   `json:"visible"`
 }
 
+// ToAssetTypeDetailsUpdate returns the fields of an asset type that can be
+// sent on create.
+func (details AssetTypeDetails) ToAssetTypeDetailsUpdate() AssetTypeDetailsUpdate {
+	return AssetTypeDetailsUpdate{
+		Description:       details.Description,
+		Name:              details.Name,
+		ParentAssetTypeID: details.ParentAssetTypeID,
+		Visible:           details.Visible,
+	}
+}
+
+// AssetTypeDetailsUpdate holds the writable fields of an asset type.
+type AssetTypeDetailsUpdate struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+	// ParentAssetTypeID can only be set on create
+	ParentAssetTypeID int64 `json:"parent_asset_type_id,omitempty"`
+	Visible           bool  `json:"visible"`
+}
+
 // AssetTypes represents a FreshService asset type
 // JSON Example:
 /*
@@ -143,3 +176,759 @@ type AssetTypeDetails struct {
 type AssetTypes struct {
 	AssetTypes []AssetTypeDetails `json:"asset_types"`
 }
+
+// AssetTypeFields represents the fields of a FreshService asset type grouped
+// by section
+// JSON Example:
+/*
+{
+    "asset_type_fields": [
+        {
+            "id": 50000043795,
+            "field_header": "Hardware",
+            "fields": [
+                {
+                    "id": 50000326470,
+                    "asset_type_id": 50000240147,
+                    "label": "Serial Number",
+                    "name": "serial_number_50000240147",
+                    "field_type": "custom_text",
+                    "data_type": "text",
+                    "mandatory": false,
+                    "choices": []
+                }
+            ]
+        }
+    ]
+}.
+*/
+type AssetTypeFields struct {
+	AssetTypeFields []AssetTypeFieldGroup `json:"asset_type_fields"`
+}
+
+// AssetTypeFieldGroup represents a section of asset type fields.
+type AssetTypeFieldGroup struct {
+	ID          int64                   `json:"id"`
+	FieldHeader string                  `json:"field_header"`
+	Fields      []AssetTypeFieldDetails `json:"fields"`
+}
+
+// AssetTypeFieldDetails represents a field of a FreshService asset type,
+// default fields like name have no asset type ID.
+type AssetTypeFieldDetails struct {
+	AssetTypeID int64  `json:"asset_type_id"`
+	DataType    string `json:"data_type"`
+	FieldType   string `json:"field_type"`
+	ID          int64  `json:"id"`
+	Label       string `json:"label"`
+	Mandatory   bool   `json:"mandatory"`
+	Name        string `json:"name"`
+	// Choices of dropdown fields, either plain values or [value, id] pairs
+	Choices []interface{} `json:"choices"`
+	// FieldHeader is the section the field is listed in, taken from its group
+	FieldHeader string `json:"-"`
+}
+
+// IsTypeField reports whether the field is a type field of an asset type
+// rather than a default asset field.
+func (field AssetTypeFieldDetails) IsTypeField() bool {
+	return field.AssetTypeID != 0
+}
+
+// PlainName returns the name of a type field without the ID of the asset
+// type defining it, e.g. disk_2 for disk_2_50000240147.
+func (field AssetTypeFieldDetails) PlainName() string {
+	if !field.IsTypeField() {
+		return field.Name
+	}
+
+	return strings.TrimSuffix(field.Name, "_"+strconv.FormatInt(field.AssetTypeID, 10))
+}
+
+// ChoiceValues returns the values a dropdown field accepts.
+func (field AssetTypeFieldDetails) ChoiceValues() []string {
+	values := make([]string, 0, len(field.Choices))
+	for _, choice := range field.Choices {
+		if pair, ok := choice.([]interface{}); ok && len(pair) > 0 {
+			choice = pair[0]
+		}
+		values = append(values, fmt.Sprint(choice))
+	}
+
+	return values
+}
+
+// RelationshipTypes represents the relationship types of FreshService
+// JSON Example:
+/*
+{
+    "relationship_types": [
+        {
+            "id": 50000123456,
+            "description": "Depends on / Used by",
+            "downstream_relation": "Depends on",
+            "upstream_relation": "Used by",
+            "created_at": "2019-02-14T10:08:02Z",
+            "updated_at": "2019-02-14T10:08:02Z"
+        }
+    ]
+}.
+*/
+type RelationshipTypes struct {
+	RelationshipTypes []RelationshipTypeDetails `json:"relationship_types"`
+}
+
+// RelationshipTypeDetails represents a FreshService relationship type, the
+// downstream relation is read from the primary to the secondary entity.
+type RelationshipTypeDetails struct {
+	CreatedAt          string `json:"created_at,omitempty"`
+	Description        string `json:"description,omitempty"`
+	DownstreamRelation string `json:"downstream_relation"`
+	ID                 int64  `json:"id,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+	UpstreamRelation   string `json:"upstream_relation"`
+}
+
+// Relationship represents a FreshService relationship
+// relationship.
+type Relationship struct {
+	RelationshipDetails RelationshipDetails `json:"relationship"`
+}
+
+// Relationships represents a list of FreshService relationships
+// relationships.
+type Relationships struct {
+	Relationships []RelationshipDetails `json:"relationships"`
+}
+
+// RelationshipDetails represents a relationship between two entities, assets
+// are referenced by their display ID.
+type RelationshipDetails struct {
+	CreatedAt          string `json:"created_at,omitempty"`
+	ID                 int64  `json:"id,omitempty"`
+	PrimaryID          int64  `json:"primary_id"`
+	PrimaryType        string `json:"primary_type"`
+	RelationshipTypeID int64  `json:"relationship_type_id"`
+	SecondaryID        int64  `json:"secondary_id"`
+	SecondaryType      string `json:"secondary_type"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+// Job represents a FreshService background job
+// JSON Example:
+/*
+{
+    "job_id": "a8b9c0d1-e2f3-4a5b-6c7d-8e9f0a1b2c3d",
+    "status": "success",
+    "href": "https://domain.freshservice.com/api/v2/jobs/a8b9c0d1-e2f3-4a5b-6c7d-8e9f0a1b2c3d",
+    "relationships": [
+        {
+            "id": 1,
+            "relationship_type_id": 50000123456,
+            "primary_id": 1,
+            "primary_type": "asset",
+            "secondary_id": 2,
+            "secondary_type": "asset",
+            "success": true
+        }
+    ]
+}.
+*/
+type Job struct {
+	Href          string                `json:"href"`
+	JobID         string                `json:"job_id"`
+	Relationships []JobRelationshipItem `json:"relationships"`
+	Status        string                `json:"status"`
+}
+
+// JobRelationshipItem is the outcome of a single relationship of a bulk
+// create job.
+type JobRelationshipItem struct {
+	RelationshipDetails
+	Errors  []interface{} `json:"errors,omitempty"`
+	Success bool          `json:"success"`
+}
+
+// RelationshipType represents a FreshService relationship type
+// relationship_type.
+type RelationshipType struct {
+	RelationshipTypeDetails RelationshipTypeDetails `json:"relationship_type"`
+}
+
+// ToRelationshipTypeDetailsUpdate returns the writable fields of a
+// relationship type.
+func (details RelationshipTypeDetails) ToRelationshipTypeDetailsUpdate() RelationshipTypeDetailsUpdate {
+	return RelationshipTypeDetailsUpdate{
+		Description:        details.Description,
+		DownstreamRelation: details.DownstreamRelation,
+		UpstreamRelation:   details.UpstreamRelation,
+	}
+}
+
+// RelationshipTypeDetailsUpdate holds the writable fields of a relationship type.
+type RelationshipTypeDetailsUpdate struct {
+	Description        string `json:"description"`
+	DownstreamRelation string `json:"downstream_relation"`
+	UpstreamRelation   string `json:"upstream_relation"`
+}
+
+// AssetComponents represents the components of a FreshService asset
+// JSON Example:
+/*
+{
+    "components": [
+        {
+            "id": 17,
+            "component_type": "Processor",
+            "component_data": {
+                "manufacturer": "Intel",
+                "model": "Xeon Gold 6130",
+                "cpu_speed": 2.1,
+                "no_of_cores": 16
+            },
+            "created_at": "2019-02-14T10:08:02Z",
+            "updated_at": "2019-02-14T10:08:02Z"
+        }
+    ]
+}.
+*/
+type AssetComponents struct {
+	Components []AssetComponentDetails `json:"components"`
+}
+
+// AssetComponentDetails represents a hardware component of an asset like a
+// processor, memory, disk or network adapter, the fields of ComponentData
+// depend on the component type.
+type AssetComponentDetails struct {
+	ComponentData map[string]interface{} `json:"component_data"`
+	ComponentType string                 `json:"component_type"`
+	CreatedAt     string                 `json:"created_at"`
+	ID            int64                  `json:"id"`
+	UpdatedAt     string                 `json:"updated_at"`
+}
+
+// Application represents a FreshService software application
+// application.
+type Application struct {
+	ApplicationDetails ApplicationDetails `json:"application"`
+}
+
+// ApplicationDetails represents a FreshService software application
+// JSON Example:
+/*
+{
+    "id": 50000123456,
+    "name": "Microsoft Office 365",
+    "description": "Office suite",
+    "application_type": "desktop",
+    "status": "managed",
+    "publisher_id": 50000012345,
+    "managed_by_id": 50000023456,
+    "notes": "",
+    "category": "Productivity",
+    "source": "API",
+    "user_count": 0,
+    "installation_count": 12,
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type ApplicationDetails struct {
+	ApplicationType   string `json:"application_type,omitempty"`
+	Category          string `json:"category,omitempty"`
+	CreatedAt         string `json:"created_at,omitempty"`
+	Description       string `json:"description,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	InstallationCount int64  `json:"installation_count,omitempty"`
+	ManagedByID       int64  `json:"managed_by_id,omitempty"`
+	Name              string `json:"name"`
+	Notes             string `json:"notes,omitempty"`
+	PublisherID       int64  `json:"publisher_id,omitempty"`
+	Source            string `json:"source,omitempty"`
+	Status            string `json:"status,omitempty"`
+	UpdatedAt         string `json:"updated_at,omitempty"`
+	UserCount         int64  `json:"user_count,omitempty"`
+}
+
+// ToApplicationDetailsUpdate returns the writable fields of an application.
+func (details ApplicationDetails) ToApplicationDetailsUpdate() ApplicationDetailsUpdate {
+	return ApplicationDetailsUpdate{
+		ApplicationType: details.ApplicationType,
+		Category:        details.Category,
+		Description:     details.Description,
+		ManagedByID:     optionalID(details.ManagedByID),
+		Name:            details.Name,
+		Notes:           details.Notes,
+		PublisherID:     optionalID(details.PublisherID),
+		Status:          details.Status,
+	}
+}
+
+// optionalID returns nil for a zero ID so that it is sent as null.
+func optionalID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+
+	return &id
+}
+
+// ApplicationDetailsUpdate holds the writable fields of an application, the
+// publisher and the managing agent are sent as null to clear them.
+type ApplicationDetailsUpdate struct {
+	ApplicationType string `json:"application_type,omitempty"`
+	Category        string `json:"category"`
+	Description     string `json:"description"`
+	ManagedByID     *int64 `json:"managed_by_id"`
+	Name            string `json:"name"`
+	Notes           string `json:"notes"`
+	PublisherID     *int64 `json:"publisher_id"`
+	Status          string `json:"status,omitempty"`
+}
+
+// Installation represents an installation of an application
+// installation.
+type Installation struct {
+	InstallationDetails InstallationDetails `json:"installation"`
+}
+
+// InstallationDetails represents an installation of an application on an
+// asset, the machine is referenced by the display ID of the asset.
+type InstallationDetails struct {
+	CreatedAt             string `json:"created_at,omitempty"`
+	ID                    int64  `json:"id,omitempty"`
+	InstallationDate      string `json:"installation_date,omitempty"`
+	InstallationMachineID int64  `json:"installation_machine_id"`
+	InstallationPath      string `json:"installation_path,omitempty"`
+	UpdatedAt             string `json:"updated_at,omitempty"`
+	Version               string `json:"version,omitempty"`
+}
+
+// Department represents a FreshService department
+// department.
+type Department struct {
+	DepartmentDetails DepartmentDetails `json:"department"`
+}
+
+// DepartmentDetails represents a FreshService department
+// JSON Example:
+/*
+{
+    "id": 50000234567,
+    "name": "Finance",
+    "description": "Accounting and payroll",
+    "head_user_id": 50000345678,
+    "prime_user_id": 50000456789,
+    "domains": ["finance.example.com"],
+    "custom_fields": {
+        "cost_center": "CC-100"
+    },
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type DepartmentDetails struct {
+	CreatedAt    string                 `json:"created_at,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Domains      []string               `json:"domains,omitempty"`
+	HeadUserID   int64                  `json:"head_user_id,omitempty"`
+	ID           int64                  `json:"id,omitempty"`
+	Name         string                 `json:"name"`
+	PrimeUserID  int64                  `json:"prime_user_id,omitempty"`
+	UpdatedAt    string                 `json:"updated_at,omitempty"`
+}
+
+// ToDepartmentDetailsUpdate returns the writable fields of a department.
+func (details DepartmentDetails) ToDepartmentDetailsUpdate() DepartmentDetailsUpdate {
+	domains := details.Domains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	return DepartmentDetailsUpdate{
+		CustomFields: details.CustomFields,
+		Description:  details.Description,
+		Domains:      domains,
+		HeadUserID:   optionalID(details.HeadUserID),
+		Name:         details.Name,
+		PrimeUserID:  optionalID(details.PrimeUserID),
+	}
+}
+
+// DepartmentDetailsUpdate holds the writable fields of a department, the head
+// and the prime user are sent as null to clear them.
+type DepartmentDetailsUpdate struct {
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	Description  string                 `json:"description"`
+	Domains      []string               `json:"domains"`
+	HeadUserID   *int64                 `json:"head_user_id"`
+	Name         string                 `json:"name"`
+	PrimeUserID  *int64                 `json:"prime_user_id"`
+}
+
+// Location represents a FreshService location
+// location.
+type Location struct {
+	LocationDetails LocationDetails `json:"location"`
+}
+
+// LocationDetails represents a FreshService location
+// JSON Example:
+/*
+{
+    "id": 50000345678,
+    "name": "Office 3",
+    "parent_location_id": 50000345677,
+    "primary_contact_id": 50000456789,
+    "address": {
+        "line1": "Friedrichstraße 123",
+        "line2": "3rd floor",
+        "city": "Berlin",
+        "state": "Berlin",
+        "country": "Germany",
+        "zipcode": "10117"
+    },
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type LocationDetails struct {
+	Address          LocationAddress `json:"address"`
+	CreatedAt        string          `json:"created_at,omitempty"`
+	ID               int64           `json:"id,omitempty"`
+	Name             string          `json:"name"`
+	ParentLocationID int64           `json:"parent_location_id,omitempty"`
+	PrimaryContactID int64           `json:"primary_contact_id,omitempty"`
+	UpdatedAt        string          `json:"updated_at,omitempty"`
+}
+
+// LocationAddress is the postal address of a location.
+type LocationAddress struct {
+	City    string `json:"city"`
+	Country string `json:"country"`
+	Line1   string `json:"line1"`
+	Line2   string `json:"line2"`
+	State   string `json:"state"`
+	Zipcode string `json:"zipcode"`
+}
+
+// ToLocationDetailsUpdate returns the writable fields of a location.
+func (details LocationDetails) ToLocationDetailsUpdate() LocationDetailsUpdate {
+	return LocationDetailsUpdate{
+		Address:          details.Address,
+		Name:             details.Name,
+		ParentLocationID: optionalID(details.ParentLocationID),
+		PrimaryContactID: optionalID(details.PrimaryContactID),
+	}
+}
+
+// LocationDetailsUpdate holds the writable fields of a location, the parent
+// and the primary contact are sent as null to clear them.
+type LocationDetailsUpdate struct {
+	Address          LocationAddress `json:"address"`
+	Name             string          `json:"name"`
+	ParentLocationID *int64          `json:"parent_location_id"`
+	PrimaryContactID *int64          `json:"primary_contact_id"`
+}
+
+// AgentGroup represents a FreshService agent group
+// group.
+type AgentGroup struct {
+	AgentGroupDetails AgentGroupDetails `json:"group"`
+}
+
+// AgentGroupDetails represents a FreshService agent group, members, observers
+// and leaders are agent IDs
+// JSON Example:
+/*
+{
+    "id": 50000567890,
+    "name": "Service Desk",
+    "description": "First level support",
+    "escalate_to": 50000678901,
+    "unassigned_for": "30m",
+    "business_hours_id": 50000012345,
+    "members": [50000678901, 50000678902],
+    "observers": [50000678903],
+    "leaders": [50000678901],
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type AgentGroupDetails struct {
+	BusinessHoursID int64   `json:"business_hours_id,omitempty"`
+	CreatedAt       string  `json:"created_at,omitempty"`
+	Description     string  `json:"description,omitempty"`
+	EscalateTo      int64   `json:"escalate_to,omitempty"`
+	ID              int64   `json:"id,omitempty"`
+	Leaders         []int64 `json:"leaders,omitempty"`
+	Members         []int64 `json:"members,omitempty"`
+	Name            string  `json:"name"`
+	Observers       []int64 `json:"observers,omitempty"`
+	UnassignedFor   string  `json:"unassigned_for,omitempty"`
+	UpdatedAt       string  `json:"updated_at,omitempty"`
+}
+
+// ToAgentGroupDetailsUpdate returns the writable fields of an agent group.
+// Members and observers are only sent when they are not nil so that they can
+// be left to the agents.
+func (details AgentGroupDetails) ToAgentGroupDetailsUpdate() AgentGroupDetailsUpdate {
+	var unassignedFor *string
+	if details.UnassignedFor != "" {
+		unassignedFor = &details.UnassignedFor
+	}
+
+	var members, observers *[]int64
+	if details.Members != nil {
+		members = &details.Members
+	}
+	if details.Observers != nil {
+		observers = &details.Observers
+	}
+
+	return AgentGroupDetailsUpdate{
+		BusinessHoursID: optionalID(details.BusinessHoursID),
+		Description:     details.Description,
+		EscalateTo:      optionalID(details.EscalateTo),
+		Leaders:         nonNilIDs(details.Leaders),
+		Members:         members,
+		Name:            details.Name,
+		Observers:       observers,
+		UnassignedFor:   unassignedFor,
+	}
+}
+
+// nonNilIDs returns an empty list for nil so that it is sent as [].
+func nonNilIDs(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+
+	return ids
+}
+
+// AgentGroupDetailsUpdate holds the writable fields of an agent group, lists
+// are sent in full and unset IDs are sent as null to clear them.
+type AgentGroupDetailsUpdate struct {
+	BusinessHoursID *int64   `json:"business_hours_id"`
+	Description     string   `json:"description"`
+	EscalateTo      *int64   `json:"escalate_to"`
+	Leaders         []int64  `json:"leaders"`
+	Members         *[]int64 `json:"members,omitempty"`
+	Name            string   `json:"name"`
+	Observers       *[]int64 `json:"observers,omitempty"`
+	UnassignedFor   *string  `json:"unassigned_for"`
+}
+
+// Requester group types, members of rule based groups are kept in sync by
+// FreshService from the rules of the group.
+const (
+	RequesterGroupTypeManual    = "manual"
+	RequesterGroupTypeRuleBased = "rule_based"
+)
+
+// RequesterGroup represents a FreshService requester group
+// requester_group.
+type RequesterGroup struct {
+	RequesterGroupDetails RequesterGroupDetails `json:"requester_group"`
+}
+
+// RequesterGroupDetails represents a FreshService requester group, the API
+// does not return or accept the rules of rule based groups
+// JSON Example:
+/*
+{
+    "id": 50000345678,
+    "name": "Berlin Office",
+    "description": "Requesters working from the Berlin office",
+    "type": "manual",
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type RequesterGroupDetails struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	Description string `json:"description,omitempty"`
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ToRequesterGroupDetailsUpdate returns the writable fields of a requester
+// group, the type can only be set on create.
+func (details RequesterGroupDetails) ToRequesterGroupDetailsUpdate() RequesterGroupDetailsUpdate {
+	return RequesterGroupDetailsUpdate{
+		Description: details.Description,
+		Name:        details.Name,
+	}
+}
+
+// RequesterGroupDetailsUpdate holds the writable fields of a requester group.
+type RequesterGroupDetailsUpdate struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// RequesterDetails represents a FreshService requester as listed in the
+// members of a requester group
+// JSON Example:
+/*
+{
+    "id": 50000456789,
+    "first_name": "Jane",
+    "last_name": "Doe",
+    "primary_email": "jane.doe@example.com",
+    "active": true,
+    "department_ids": [50000234567],
+    "location_id": 50000123456,
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type RequesterDetails struct {
+	Active        bool    `json:"active"`
+	CreatedAt     string  `json:"created_at,omitempty"`
+	DepartmentIDs []int64 `json:"department_ids,omitempty"`
+	FirstName     string  `json:"first_name"`
+	ID            int64   `json:"id,omitempty"`
+	LastName      string  `json:"last_name,omitempty"`
+	LocationID    int64   `json:"location_id,omitempty"`
+	PrimaryEmail  string  `json:"primary_email"`
+	UpdatedAt     string  `json:"updated_at,omitempty"`
+}
+
+// Agent states to filter ListAgents by.
+const (
+	AgentStateFulltime   = "fulltime"
+	AgentStateOccasional = "occasional"
+)
+
+// Agent role assignment scopes, groups are only set for
+// AgentScopeSpecifiedGroups.
+const (
+	AgentScopeEntireHelpdesk  = "entire_helpdesk"
+	AgentScopeMemberGroups    = "member_groups"
+	AgentScopeSpecifiedGroups = "specified_groups"
+	AgentScopeAssignedItems   = "assigned_items"
+)
+
+// Agent represents a FreshService agent
+// agent.
+type Agent struct {
+	AgentDetails AgentDetails `json:"agent"`
+}
+
+// AgentDetails represents a FreshService agent, member_of and observer_of are
+// agent group IDs
+// JSON Example:
+/*
+{
+    "id": 50000678901,
+    "first_name": "Alex",
+    "last_name": "Admin",
+    "email": "alex.admin@example.com",
+    "job_title": "IT Engineer",
+    "work_phone_number": "+49 30 1234567",
+    "mobile_phone_number": null,
+    "occasional": false,
+    "active": true,
+    "department_ids": [50000234567],
+    "location_id": 50000123456,
+    "reporting_manager_id": null,
+    "member_of": [50000567890],
+    "observer_of": [],
+    "roles": [
+        {
+            "role_id": 50000012345,
+            "assignment_scope": "specified_groups",
+            "groups": [50000567890],
+            "workspace_id": 2
+        }
+    ],
+    "created_at": "2019-02-14T10:08:02Z",
+    "updated_at": "2019-02-14T10:08:02Z"
+}.
+*/
+type AgentDetails struct {
+	Active             bool        `json:"active"`
+	CreatedAt          string      `json:"created_at,omitempty"`
+	DepartmentIDs      []int64     `json:"department_ids,omitempty"`
+	Email              string      `json:"email"`
+	FirstName          string      `json:"first_name"`
+	ID                 int64       `json:"id,omitempty"`
+	JobTitle           string      `json:"job_title,omitempty"`
+	LastName           string      `json:"last_name,omitempty"`
+	LocationID         int64       `json:"location_id,omitempty"`
+	MemberOf           []int64     `json:"member_of,omitempty"`
+	MobilePhoneNumber  string      `json:"mobile_phone_number,omitempty"`
+	ObserverOf         []int64     `json:"observer_of,omitempty"`
+	Occasional         bool        `json:"occasional"`
+	ReportingManagerID int64       `json:"reporting_manager_id,omitempty"`
+	Roles              []AgentRole `json:"roles,omitempty"`
+	UpdatedAt          string      `json:"updated_at,omitempty"`
+	WorkPhoneNumber    string      `json:"work_phone_number,omitempty"`
+}
+
+// AgentRole is a role assigned to an agent, the workspace ID is 0 for
+// accounts without workspaces.
+type AgentRole struct {
+	AssignmentScope string  `json:"assignment_scope"`
+	Groups          []int64 `json:"groups"`
+	RoleID          int64   `json:"role_id"`
+	WorkspaceID     int64   `json:"workspace_id,omitempty"`
+}
+
+// ToAgentDetailsUpdate returns the writable fields of an agent. Group
+// memberships are only sent when MemberOf or ObserverOf are not nil so that
+// they can be left to the agent groups.
+func (details AgentDetails) ToAgentDetailsUpdate() AgentDetailsUpdate {
+	roles := make([]AgentRole, 0, len(details.Roles))
+	for _, role := range details.Roles {
+		role.Groups = nonNilIDs(role.Groups)
+		roles = append(roles, role)
+	}
+
+	var memberOf, observerOf *[]int64
+	if details.MemberOf != nil {
+		memberOf = &details.MemberOf
+	}
+	if details.ObserverOf != nil {
+		observerOf = &details.ObserverOf
+	}
+
+	return AgentDetailsUpdate{
+		DepartmentIDs:      nonNilIDs(details.DepartmentIDs),
+		Email:              details.Email,
+		FirstName:          details.FirstName,
+		JobTitle:           details.JobTitle,
+		LastName:           details.LastName,
+		LocationID:         optionalID(details.LocationID),
+		MemberOf:           memberOf,
+		MobilePhoneNumber:  details.MobilePhoneNumber,
+		ObserverOf:         observerOf,
+		Occasional:         details.Occasional,
+		ReportingManagerID: optionalID(details.ReportingManagerID),
+		Roles:              roles,
+		WorkPhoneNumber:    details.WorkPhoneNumber,
+	}
+}
+
+// AgentDetailsUpdate holds the writable fields of an agent, unset IDs are
+// sent as null to clear them.
+type AgentDetailsUpdate struct {
+	DepartmentIDs      []int64     `json:"department_ids"`
+	Email              string      `json:"email"`
+	FirstName          string      `json:"first_name"`
+	JobTitle           string      `json:"job_title"`
+	LastName           string      `json:"last_name"`
+	LocationID         *int64      `json:"location_id"`
+	MemberOf           *[]int64    `json:"member_of,omitempty"`
+	MobilePhoneNumber  string      `json:"mobile_phone_number"`
+	ObserverOf         *[]int64    `json:"observer_of,omitempty"`
+	Occasional         bool        `json:"occasional"`
+	ReportingManagerID *int64      `json:"reporting_manager_id"`
+	Roles              []AgentRole `json:"roles"`
+	WorkPhoneNumber    string      `json:"work_phone_number"`
+}
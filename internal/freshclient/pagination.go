@@ -0,0 +1,104 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// DefaultPerPage is the page size requested from collection endpoints, it is
+// the maximum allowed by the FreshService API.
+const DefaultPerPage = 100
+
+// ErrStopPagination can be returned by a ListPages callback to stop walking
+// the collection without reporting an error.
+var ErrStopPagination = errors.New("stop pagination")
+
+// linkNextPattern matches the next page URL in a Link header
+// e.g. <https://domain.freshservice.com/api/v2/assets?page=2>; rel="next".
+var linkNextPattern = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+// ListPages walks every page of the collection at rawURL and calls fn with
+// the items listed under key, e.g. "assets" for /assets.
+// The next page is taken from the Link header, when the API never sends one
+// the page counter is increased for as long as full pages are returned.
+func ListPages[T any](ctx context.Context, client *Client, rawURL string, key string, fn func(items []T) error) error {
+	pageURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	query := pageURL.Query()
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = DefaultPerPage
+		query.Set("per_page", strconv.Itoa(perPage))
+	}
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+		query.Set("page", strconv.Itoa(page))
+	}
+	pageURL.RawQuery = query.Encode()
+	next := pageURL.String()
+	usesLinks := false
+
+	for next != "" {
+		resp, err := client.MakeRequest(ctx, "GET", next, nil)
+		if err != nil {
+			return err
+		}
+
+		var body map[string]json.RawMessage
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		var items []T
+		if raw, ok := body[key]; ok {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return fmt.Errorf("decoding %s: %w", key, err)
+			}
+		}
+
+		if err := fn(items); err != nil {
+			if errors.Is(err, ErrStopPagination) {
+				return nil
+			}
+			return err
+		}
+
+		next = ""
+		if match := linkNextPattern.FindStringSubmatch(resp.Header.Get("Link")); match != nil {
+			next = match[1]
+			usesLinks = true
+		} else if !usesLinks && len(items) >= perPage {
+			page++
+			query.Set("page", strconv.Itoa(page))
+			pageURL.RawQuery = query.Encode()
+			next = pageURL.String()
+		}
+	}
+
+	return nil
+}
+
+// ListAll returns every item of the collection at rawURL listed under key.
+func ListAll[T any](ctx context.Context, client *Client, rawURL string, key string) ([]T, error) {
+	var all []T
+	err := ListPages(ctx, client, rawURL, key, func(items []T) error {
+		all = append(all, items...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return all, nil
+}
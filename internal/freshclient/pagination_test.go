@@ -0,0 +1,84 @@
+package freshclient
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// TestListPagesLink tests that the next page is followed through the Link header.
+func TestListPagesLink(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 3 {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/asset_types?page=%d&per_page=1>; rel="next"`, server.URL, page+1))
+		}
+		fmt.Fprintf(w, `{"asset_types":[{"id":%d,"name":"Type %d"}]}`, page, page)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+
+	got, err := ListAll[AssetTypeDetails](context.Background(), client, server.URL+"/asset_types?per_page=1", "asset_types")
+	if err != nil {
+		t.Errorf("freshclient.ListAll() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if len(got) != 3 || got[2].ID != 3 {
+		t.Errorf("freshclient.ListAll() = %v, want %v", got, "3 asset types")
+	}
+}
+
+// TestListPagesCounter tests that full pages are followed by the next page without a Link header.
+func TestListPagesCounter(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"assets":[{"display_id":1},{"display_id":2}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"assets":[{"display_id":3}]}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+
+	got, err := ListAll[AssetDetails](context.Background(), client, server.URL+"/assets?per_page=2", "assets")
+	if err != nil {
+		t.Errorf("freshclient.ListAll() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if len(got) != 3 || requests != 2 {
+		t.Errorf("freshclient.ListAll() = %v in %d requests, want %v", got, requests, "3 assets in 2 requests")
+	}
+}
+
+// TestListPagesStop tests that returning ErrStopPagination ends the walk without error.
+func TestListPagesStop(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"assets":[{"display_id":1}]}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+
+	err := ListPages(context.Background(), client, server.URL+"/assets?per_page=1", "assets", func(assets []AssetDetails) error {
+		return ErrStopPagination
+	})
+	if err != nil {
+		t.Errorf("freshclient.ListPages() error = %v, want %v", err, nil)
+	}
+
+	if requests != 1 {
+		t.Errorf("freshclient.ListPages() requests = %v, want %v", requests, 1)
+	}
+}
@@ -0,0 +1,96 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RelationshipEntityAsset is the primary and secondary type of relationships
+// between assets.
+const RelationshipEntityAsset = "asset"
+
+// CreateRelationships creates relationships in the FreshService API. The API
+// creates them in a background job, which is polled until it is finished.
+// When some relationships fail the created ones are returned with an error.
+func (client *Client) CreateRelationships(ctx context.Context, relationships []RelationshipDetails) ([]RelationshipDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/relationships/bulk-create", Relationships{Relationships: relationships})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var queued Job
+	if err := json.NewDecoder(resp.Body).Decode(&queued); err != nil {
+		return nil, err
+	}
+
+	job, err := client.WaitForJob(ctx, queued.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []RelationshipDetails
+	var failures []string
+	for i, item := range job.Relationships {
+		if item.Success {
+			created = append(created, item.RelationshipDetails)
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("relationship %d of %s %d to %s %d: %v", i+1, item.PrimaryType, item.PrimaryID, item.SecondaryType, item.SecondaryID, item.Errors))
+	}
+
+	if len(failures) > 0 || job.Status != JobStatusSuccess {
+		return created, fmt.Errorf("job %s finished with status %s: %s", job.JobID, job.Status, strings.Join(failures, ", "))
+	}
+
+	return created, nil
+}
+
+// GetRelationship gets a relationship from the FreshService API.
+func (client *Client) GetRelationship(ctx context.Context, id int64) (*RelationshipDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/relationships/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var relationship Relationship
+	if err := json.NewDecoder(resp.Body).Decode(&relationship); err != nil {
+		return nil, err
+	}
+
+	return &relationship.RelationshipDetails, nil
+}
+
+// ListRelationships lists every relationship from the FreshService API.
+func (client *Client) ListRelationships(ctx context.Context) ([]RelationshipDetails, error) {
+	return ListAll[RelationshipDetails](ctx, client, *client.APIEndpoint+"/relationships", "relationships")
+}
+
+// ListAssetRelationships lists the relationships of an asset in either
+// direction from the FreshService API.
+func (client *Client) ListAssetRelationships(ctx context.Context, assetDisplayID int64) ([]RelationshipDetails, error) {
+	return ListAll[RelationshipDetails](ctx, client, *client.APIEndpoint+"/assets/"+strconv.FormatInt(assetDisplayID, 10)+"/relationships", "relationships")
+}
+
+// DeleteRelationships deletes relationships from the FreshService API.
+func (client *Client) DeleteRelationships(ctx context.Context, ids ...int64) error {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.FormatInt(id, 10))
+	}
+	query := url.Values{}
+	query.Set("ids", strings.Join(values, ","))
+
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/relationships?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
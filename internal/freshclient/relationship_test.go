@@ -0,0 +1,88 @@
+package freshclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestRelationships tests creating, reading and deleting a relationship.
+func TestRelationships(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	relationshipTypeID := server.AddRelationshipType("Runs On", "Runs")
+	vm := server.AddAsset(map[string]interface{}{"name": "TestGolangVM", "asset_type_id": 1})
+	host := server.AddAsset(map[string]interface{}{"name": "TestGolangHost", "asset_type_id": 1})
+
+	relationshipTypes, err := client.ListRelationshipTypes(ctx)
+	if err != nil || len(relationshipTypes) != 1 || relationshipTypes[0].DownstreamRelation != "Runs On" {
+		t.Errorf("freshclient.ListRelationshipTypes() = %v, %v, want the Runs On type", relationshipTypes, err)
+	}
+
+	created, err := client.CreateRelationships(ctx, []RelationshipDetails{{
+		RelationshipTypeID: relationshipTypeID,
+		PrimaryID:          vm,
+		PrimaryType:        RelationshipEntityAsset,
+		SecondaryID:        host,
+		SecondaryType:      RelationshipEntityAsset,
+	}})
+	if err != nil {
+		t.Errorf("freshclient.CreateRelationships() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(created) != 1 || created[0].ID == 0 {
+		t.Errorf("freshclient.CreateRelationships() = %v, want 1 relationship with an ID", created)
+		t.FailNow()
+	}
+
+	got, err := client.GetRelationship(ctx, created[0].ID)
+	if err != nil {
+		t.Errorf("freshclient.GetRelationship() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if got.PrimaryID != vm || got.SecondaryID != host || got.RelationshipTypeID != relationshipTypeID {
+		t.Errorf("freshclient.GetRelationship() = %+v, want the created relationship", got)
+	}
+
+	for _, displayID := range []int64{vm, host} {
+		relationships, err := client.ListAssetRelationships(ctx, displayID)
+		if err != nil || len(relationships) != 1 {
+			t.Errorf("freshclient.ListAssetRelationships(%d) = %v, %v, want 1 relationship", displayID, relationships, err)
+		}
+	}
+
+	if err := client.DeleteRelationships(ctx, created[0].ID); err != nil {
+		t.Errorf("freshclient.DeleteRelationships() error = %v, want %v", err, nil)
+	}
+
+	if _, err := client.GetRelationship(ctx, created[0].ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetRelationship() error = %v, want not found", err)
+	}
+}
+
+// TestCreateRelationshipsPartial tests that failed relationships of a bulk
+// create are reported and the created ones returned.
+func TestCreateRelationshipsPartial(t *testing.T) {
+	client, server := newFakeClient(t)
+	relationshipTypeID := server.AddRelationshipType("Depends On", "Used By")
+	service := server.AddAsset(map[string]interface{}{"name": "TestGolangService", "asset_type_id": 1})
+	database := server.AddAsset(map[string]interface{}{"name": "TestGolangDatabase", "asset_type_id": 1})
+
+	relationship := RelationshipDetails{
+		RelationshipTypeID: relationshipTypeID,
+		PrimaryID:          service,
+		PrimaryType:        RelationshipEntityAsset,
+		SecondaryID:        database,
+		SecondaryType:      RelationshipEntityAsset,
+	}
+	missing := relationship
+	missing.SecondaryID = 999
+
+	created, err := client.CreateRelationships(context.Background(), []RelationshipDetails{relationship, missing})
+	if err == nil || !strings.Contains(err.Error(), "partial") || !strings.Contains(err.Error(), "secondary_id") {
+		t.Errorf("freshclient.CreateRelationships() error = %v, want a partial job error", err)
+	}
+	if len(created) != 1 || created[0].SecondaryID != database {
+		t.Errorf("freshclient.CreateRelationships() = %v, want the valid relationship", created)
+	}
+}
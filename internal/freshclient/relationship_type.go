@@ -0,0 +1,71 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// ListRelationshipTypes lists every relationship type from the FreshService API.
+func (client *Client) ListRelationshipTypes(ctx context.Context) ([]RelationshipTypeDetails, error) {
+	return ListAll[RelationshipTypeDetails](ctx, client, *client.APIEndpoint+"/relationship_types", "relationship_types")
+}
+
+// CreateRelationshipType creates a relationship type in the FreshService API.
+func (client *Client) CreateRelationshipType(ctx context.Context, relationshipTypeDetails RelationshipTypeDetails) (*RelationshipTypeDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/relationship_types", relationshipTypeDetails.ToRelationshipTypeDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newRelationshipType RelationshipType
+	if err := json.NewDecoder(resp.Body).Decode(&newRelationshipType); err != nil {
+		return nil, err
+	}
+
+	return &newRelationshipType.RelationshipTypeDetails, nil
+}
+
+// GetRelationshipType gets a relationship type from the FreshService API.
+func (client *Client) GetRelationshipType(ctx context.Context, id int64) (*RelationshipTypeDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/relationship_types/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var relationshipType RelationshipType
+	if err := json.NewDecoder(resp.Body).Decode(&relationshipType); err != nil {
+		return nil, err
+	}
+
+	return &relationshipType.RelationshipTypeDetails, nil
+}
+
+// UpdateRelationshipType updates a relationship type in the FreshService API.
+func (client *Client) UpdateRelationshipType(ctx context.Context, relationshipTypeDetails RelationshipTypeDetails) (*RelationshipTypeDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/relationship_types/"+strconv.FormatInt(relationshipTypeDetails.ID, 10), relationshipTypeDetails.ToRelationshipTypeDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedRelationshipType RelationshipType
+	if err := json.NewDecoder(resp.Body).Decode(&updatedRelationshipType); err != nil {
+		return nil, err
+	}
+
+	return &updatedRelationshipType.RelationshipTypeDetails, nil
+}
+
+// DeleteRelationshipType deletes a relationship type from the FreshService API.
+func (client *Client) DeleteRelationshipType(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/relationship_types/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
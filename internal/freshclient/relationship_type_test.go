@@ -0,0 +1,50 @@
+package freshclient
+
+import (
+	"context"
+	"testing"
+)
+
+// TestRelationshipTypeCRUD tests creating, updating and deleting a relationship type.
+func TestRelationshipTypeCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateRelationshipType(ctx, RelationshipTypeDetails{
+		DownstreamRelation: "TestGolang Replicates To",
+		UpstreamRelation:   "TestGolang Replicated From",
+		Description:        "Created by Go",
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateRelationshipType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	created.UpstreamRelation = "TestGolang Replica Of"
+	updated, err := client.UpdateRelationshipType(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateRelationshipType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.UpstreamRelation != "TestGolang Replica Of" || updated.DownstreamRelation != "TestGolang Replicates To" {
+		t.Errorf("freshclient.UpdateRelationshipType() = %+v, want the upstream relation updated", updated)
+	}
+
+	got, err := client.GetRelationshipType(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.GetRelationshipType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if got.UpstreamRelation != "TestGolang Replica Of" || got.Description != "Created by Go" {
+		t.Errorf("freshclient.GetRelationshipType() = %+v, want the updated relationship type", got)
+	}
+
+	if err := client.DeleteRelationshipType(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteRelationshipType() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetRelationshipType(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetRelationshipType() error = %v, want not found", err)
+	}
+}
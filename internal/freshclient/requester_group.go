@@ -0,0 +1,100 @@
+package freshclient
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// ListRequesterGroups lists every requester group from the FreshService API.
+func (client *Client) ListRequesterGroups(ctx context.Context) ([]RequesterGroupDetails, error) {
+	return ListAll[RequesterGroupDetails](ctx, client, *client.APIEndpoint+"/requester_groups", "requester_groups")
+}
+
+// CreateRequesterGroup creates a requester group in the FreshService API.
+func (client *Client) CreateRequesterGroup(ctx context.Context, requesterGroupDetails RequesterGroupDetails) (*RequesterGroupDetails, error) {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/requester_groups", requesterGroupDetails)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var newRequesterGroup RequesterGroup
+	if err := json.NewDecoder(resp.Body).Decode(&newRequesterGroup); err != nil {
+		return nil, err
+	}
+
+	return &newRequesterGroup.RequesterGroupDetails, nil
+}
+
+// GetRequesterGroup gets a requester group from the FreshService API.
+func (client *Client) GetRequesterGroup(ctx context.Context, id int64) (*RequesterGroupDetails, error) {
+	resp, err := client.MakeRequest(ctx, "GET", *client.APIEndpoint+"/requester_groups/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var requesterGroup RequesterGroup
+	if err := json.NewDecoder(resp.Body).Decode(&requesterGroup); err != nil {
+		return nil, err
+	}
+
+	return &requesterGroup.RequesterGroupDetails, nil
+}
+
+// UpdateRequesterGroup updates a requester group in the FreshService API.
+func (client *Client) UpdateRequesterGroup(ctx context.Context, requesterGroupDetails RequesterGroupDetails) (*RequesterGroupDetails, error) {
+	resp, err := client.MakeRequest(ctx, "PUT", *client.APIEndpoint+"/requester_groups/"+strconv.FormatInt(requesterGroupDetails.ID, 10), requesterGroupDetails.ToRequesterGroupDetailsUpdate())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var updatedRequesterGroup RequesterGroup
+	if err := json.NewDecoder(resp.Body).Decode(&updatedRequesterGroup); err != nil {
+		return nil, err
+	}
+
+	return &updatedRequesterGroup.RequesterGroupDetails, nil
+}
+
+// DeleteRequesterGroup deletes a requester group from the FreshService API.
+func (client *Client) DeleteRequesterGroup(ctx context.Context, id int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/requester_groups/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ListRequesterGroupMembers lists the requesters in a requester group, for
+// rule based groups these are the requesters matching the rules.
+func (client *Client) ListRequesterGroupMembers(ctx context.Context, id int64) ([]RequesterDetails, error) {
+	return ListAll[RequesterDetails](ctx, client, *client.APIEndpoint+"/requester_groups/"+strconv.FormatInt(id, 10)+"/members", "requesters")
+}
+
+// AddRequesterGroupMember adds a requester to a manual requester group.
+func (client *Client) AddRequesterGroupMember(ctx context.Context, id int64, requesterID int64) error {
+	resp, err := client.MakeRequest(ctx, "POST", *client.APIEndpoint+"/requester_groups/"+strconv.FormatInt(id, 10)+"/members/"+strconv.FormatInt(requesterID, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// RemoveRequesterGroupMember removes a requester from a manual requester
+// group.
+func (client *Client) RemoveRequesterGroupMember(ctx context.Context, id int64, requesterID int64) error {
+	resp, err := client.MakeRequest(ctx, "DELETE", *client.APIEndpoint+"/requester_groups/"+strconv.FormatInt(id, 10)+"/members/"+strconv.FormatInt(requesterID, 10), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
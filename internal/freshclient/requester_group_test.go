@@ -0,0 +1,99 @@
+package freshclient
+
+import (
+	"context"
+	"testing"
+)
+
+// TestRequesterGroupCRUD tests creating, updating and deleting a requester
+// group.
+func TestRequesterGroupCRUD(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateRequesterGroup(ctx, RequesterGroupDetails{
+		Name:        "TestGolang Berlin Office",
+		Description: "Created by Go",
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateRequesterGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if created.Type != RequesterGroupTypeManual {
+		t.Errorf("freshclient.CreateRequesterGroup() type = %v, want %v", created.Type, RequesterGroupTypeManual)
+	}
+
+	created.Description = "Updated by Go"
+	updated, err := client.UpdateRequesterGroup(ctx, *created)
+	if err != nil {
+		t.Errorf("freshclient.UpdateRequesterGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if updated.Description != "Updated by Go" || updated.Type != RequesterGroupTypeManual {
+		t.Errorf("freshclient.UpdateRequesterGroup() = %+v, want the description updated", updated)
+	}
+
+	members, err := client.ListRequesterGroupMembers(ctx, created.ID)
+	if err != nil {
+		t.Errorf("freshclient.ListRequesterGroupMembers() error = %v, want %v", err, nil)
+	}
+	if len(members) != 0 {
+		t.Errorf("freshclient.ListRequesterGroupMembers() = %+v, want no members", members)
+	}
+
+	if err := client.DeleteRequesterGroup(ctx, created.ID); err != nil {
+		t.Errorf("freshclient.DeleteRequesterGroup() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if _, err := client.GetRequesterGroup(ctx, created.ID); !IsNotFound(err) {
+		t.Errorf("freshclient.GetRequesterGroup() error = %v, want not found", err)
+	}
+}
+
+// TestRequesterGroupMembers tests adding and removing members of manual
+// groups and that rule based groups refuse them.
+func TestRequesterGroupMembers(t *testing.T) {
+	client, server := newFakeClient(t)
+	ctx := context.Background()
+	jane := server.AddRequester("Jane", "jane@example.com")
+	john := server.AddRequester("John", "john@example.com")
+	manualID := server.AddRequesterGroup("TestGolang Finance", RequesterGroupTypeManual)
+	ruleBasedID := server.AddRequesterGroup("TestGolang Berlin", RequesterGroupTypeRuleBased, john)
+
+	if err := client.AddRequesterGroupMember(ctx, manualID, jane); err != nil {
+		t.Errorf("freshclient.AddRequesterGroupMember() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if err := client.AddRequesterGroupMember(ctx, manualID, jane); err == nil {
+		t.Errorf("freshclient.AddRequesterGroupMember() error = %v, want a duplicate member error", err)
+	}
+	if err := client.AddRequesterGroupMember(ctx, ruleBasedID, jane); err == nil {
+		t.Errorf("freshclient.AddRequesterGroupMember() error = %v, want an error for a rule based group", err)
+	}
+
+	members, err := client.ListRequesterGroupMembers(ctx, manualID)
+	if err != nil {
+		t.Errorf("freshclient.ListRequesterGroupMembers() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(members) != 1 || members[0].ID != jane || members[0].PrimaryEmail != "jane@example.com" {
+		t.Errorf("freshclient.ListRequesterGroupMembers() = %+v, want Jane", members)
+	}
+
+	members, err = client.ListRequesterGroupMembers(ctx, ruleBasedID)
+	if err != nil {
+		t.Errorf("freshclient.ListRequesterGroupMembers() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	if len(members) != 1 || members[0].ID != john {
+		t.Errorf("freshclient.ListRequesterGroupMembers() = %+v, want John", members)
+	}
+
+	if err := client.RemoveRequesterGroupMember(ctx, manualID, jane); err != nil {
+		t.Errorf("freshclient.RemoveRequesterGroupMember() error = %v, want %v", err, nil)
+	}
+	if err := client.RemoveRequesterGroupMember(ctx, manualID, jane); !IsNotFound(err) {
+		t.Errorf("freshclient.RemoveRequesterGroupMember() error = %v, want not found", err)
+	}
+}
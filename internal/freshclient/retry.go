@@ -0,0 +1,109 @@
+package freshclient
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	http "net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings used by NewClient.
+const (
+	DefaultMaxAttempts  = 5
+	DefaultMaxRetryWait = 60 * time.Second
+)
+
+// retryBaseWait is the wait before the first retry of a failed request, it
+// doubles on every following attempt.
+const retryBaseWait = 1 * time.Second
+
+// retryableStatus reports whether a response with the given status code
+// should be retried. Rate limited requests were not processed and are always
+// retried, server errors only for idempotent methods as the request may have
+// been applied.
+func retryableStatus(method string, code int) bool {
+	switch code {
+	case ErrRateLimitExceeded:
+		return true
+	case ErrUnexpectedServerError,
+		ErrBadGateway,
+		ErrServiceUnavailable,
+		ErrGatewayTimeout:
+		return idempotent(method)
+	}
+	return false
+}
+
+// idempotent reports whether a request with the given method can be replayed
+// without side effects, a replayed POST could create a duplicate.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// backoff returns the exponential backoff with jitter before the given retry
+// attempt (starting at 1), capped at the client's MaxRetryWait.
+func (client *Client) backoff(attempt int) time.Duration {
+	wait := retryBaseWait << (attempt - 1)
+	if wait <= 0 || wait > client.MaxRetryWait {
+		wait = client.MaxRetryWait
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Full jitter on the upper half so concurrent requests spread out.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// retryAfter parses the Retry-After header of a response, which is either
+// a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			seconds = 0
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// discardBody drains and closes a response body so the connection can be
+// reused for the next attempt.
+func discardBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// sleep waits for the given duration or until ctx is done.
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
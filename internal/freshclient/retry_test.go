@@ -0,0 +1,204 @@
+package freshclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	http "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestMakeRequestRetry tests that throttled and failing requests are retried.
+func TestMakeRequestRetry(t *testing.T) {
+	var attempts atomic.Int32
+	var mu sync.Mutex
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := attempts.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+
+		switch attempt {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+	client.MaxRetryWait = 10 * time.Millisecond
+
+	resp, err := client.MakeRequest(context.Background(), "PUT", server.URL+"/assets/1", map[string]string{"name": "retry"})
+	if err != nil {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	resp.Body.Close()
+
+	if attempts.Load() != 3 {
+		t.Errorf("freshclient.MakeRequest() attempts = %v, want %v", attempts.Load(), 3)
+	}
+
+	// Every attempt must replay the full body
+	mu.Lock()
+	defer mu.Unlock()
+	for _, body := range bodies {
+		if body != `{"name":"retry"}` {
+			t.Errorf("freshclient.MakeRequest() body = %v, want %v", body, `{"name":"retry"}`)
+		}
+	}
+}
+
+// TestMakeRequestRetryExhausted tests that the last error is returned once all attempts are used.
+func TestMakeRequestRetryExhausted(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+	client.MaxAttempts = 2
+	client.MaxRetryWait = 10 * time.Millisecond
+
+	_, err := client.MakeRequest(context.Background(), "GET", server.URL+"/assets/1", nil)
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.Code != ErrServiceUnavailable {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, ErrServiceUnavailable)
+	}
+
+	if attempts.Load() != 2 {
+		t.Errorf("freshclient.MakeRequest() attempts = %v, want %v", attempts.Load(), 2)
+	}
+}
+
+// TestMakeRequestRetryAfterTooLong tests that a Retry-After above MaxRetryWait
+// is capped to MaxRetryWait before retrying.
+func TestMakeRequestRetryAfterTooLong(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+	client.MaxRetryWait = 10 * time.Millisecond
+
+	start := time.Now()
+	resp, err := client.MakeRequest(context.Background(), "GET", server.URL+"/assets/1", nil)
+	if err != nil {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	resp.Body.Close()
+
+	if attempts.Load() != 2 {
+		t.Errorf("freshclient.MakeRequest() attempts = %v, want %v", attempts.Load(), 2)
+	}
+
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("freshclient.MakeRequest() waited %v, want at most %v", time.Since(start), client.MaxRetryWait)
+	}
+}
+
+// TestMakeRequestPostNotReplayed tests that a POST is not replayed after a
+// server error or a network failure, but is retried when rate limited.
+func TestMakeRequestPostNotReplayed(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := attempts.Add(1)
+		switch r.URL.Path {
+		case "/throttled":
+			if attempt == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{}`))
+		case "/failing":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+	client.MaxRetryWait = 10 * time.Millisecond
+
+	_, err := client.MakeRequest(context.Background(), "POST", server.URL+"/assets", map[string]string{"name": "once"})
+	if err == nil {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, "network error")
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("freshclient.MakeRequest() attempts = %v, want %v", attempts.Load(), 1)
+	}
+
+	attempts.Store(0)
+	_, err = client.MakeRequest(context.Background(), "POST", server.URL+"/failing", map[string]string{"name": "once"})
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.Code != ErrBadGateway {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, ErrBadGateway)
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("freshclient.MakeRequest() attempts = %v, want %v", attempts.Load(), 1)
+	}
+
+	attempts.Store(0)
+	resp, err := client.MakeRequest(context.Background(), "POST", server.URL+"/throttled", map[string]string{"name": "once"})
+	if err != nil {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+	resp.Body.Close()
+	if attempts.Load() != 2 {
+		t.Errorf("freshclient.MakeRequest() attempts = %v, want %v", attempts.Load(), 2)
+	}
+}
+
+// TestMakeRequestCancel tests that a cancelled context stops pending retries.
+func TestMakeRequestCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("key", server.URL)
+
+	start := time.Now()
+	_, err := client.MakeRequest(ctx, "GET", server.URL+"/assets/1", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("freshclient.MakeRequest() error = %v, want %v", err, context.Canceled)
+	}
+
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("freshclient.MakeRequest() waited %v after cancel", time.Since(start))
+	}
+}
@@ -0,0 +1,95 @@
+package freshclient
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// typeFieldSuffix matches the asset type ID FreshService appends to the
+// name of a type field, e.g. serial_number_50000240147.
+var typeFieldSuffix = regexp.MustCompile(`_\d+$`)
+
+// TypeFieldName returns the name of a type field key returned by the API
+// without the asset type ID suffix. Keys returned by the API always carry
+// the suffix, so only the last _<digits> is removed.
+func TypeFieldName(key string) string {
+	return typeFieldSuffix.ReplaceAllString(key, "")
+}
+
+// TrimTypeFields returns the type fields returned by the API keyed by their
+// plain names.
+func TrimTypeFields(typeFields map[string]interface{}) map[string]interface{} {
+	if typeFields == nil {
+		return nil
+	}
+
+	trimmed := make(map[string]interface{}, len(typeFields))
+	for key, value := range typeFields {
+		trimmed[TypeFieldName(key)] = value
+	}
+
+	return trimmed
+}
+
+// LookupTypeField returns the value of a type field returned by the API by
+// its full key or its plain name. Only the suffix of the API keys is
+// removed, so plain names ending in _<digits> like disk_2 are kept as is.
+func LookupTypeField(typeFields map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := typeFields[name]; ok {
+		return value, true
+	}
+
+	for key, value := range typeFields {
+		if TypeFieldName(key) == name {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// ExpandTypeFields returns the type fields keyed the way the API expects.
+// Names are matched against known first, which maps plain names to the keys
+// of the asset type and its parents and also accepts the keys themselves.
+// Other names are suffixed with assetTypeID unless they already carry it.
+func ExpandTypeFields(typeFields map[string]interface{}, assetTypeID int64, known map[string]string) map[string]interface{} {
+	if typeFields == nil {
+		return nil
+	}
+
+	keys := make(map[string]bool, len(known))
+	for _, key := range known {
+		keys[key] = true
+	}
+
+	suffix := "_" + strconv.FormatInt(assetTypeID, 10)
+	expanded := make(map[string]interface{}, len(typeFields))
+	for name, value := range typeFields {
+		key, ok := known[name]
+		switch {
+		case ok:
+		case keys[name], strings.HasSuffix(name, suffix):
+			key = name
+		default:
+			key = name + suffix
+		}
+		expanded[key] = value
+	}
+
+	return expanded
+}
+
+// KnownTypeFields maps the plain names of the type fields of an asset type
+// as returned by GetAssetTypeFields to their keys, for use as known in
+// ExpandTypeFields.
+func KnownTypeFields(fields []AssetTypeFieldDetails) map[string]string {
+	known := map[string]string{}
+	for _, field := range fields {
+		if field.IsTypeField() {
+			known[field.PlainName()] = field.Name
+		}
+	}
+
+	return known
+}
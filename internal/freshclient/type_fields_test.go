@@ -0,0 +1,120 @@
+package freshclient
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestTypeFieldName(t *testing.T) {
+	tests := map[string]string{
+		"serial_number_50000240147": "serial_number",
+		"serial_number":             "serial_number",
+		"ip_address_1":              "ip_address",
+	}
+
+	for key, want := range tests {
+		if got := TypeFieldName(key); got != want {
+			t.Errorf("freshclient.TypeFieldName(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestExpandTypeFields(t *testing.T) {
+	typeFields := map[string]interface{}{
+		"serial_number": "SN-1",
+		"vendor":        "Dell",
+		"cost_7":        "1200",
+		"disk_2":        "512 GB",
+		"ip_address_1":  "10.0.0.1",
+		"environment_5": "production",
+		"rack_position": "U12",
+	}
+	known := map[string]string{
+		"vendor":        "vendor_3",
+		"cost":          "cost_7",
+		"disk_2":        "disk_2_5",
+		"rack_position": "rack_position_3",
+	}
+
+	got := ExpandTypeFields(typeFields, 5, known)
+	want := map[string]interface{}{
+		"serial_number_5": "SN-1",
+		"vendor_3":        "Dell",
+		"cost_7":          "1200",
+		"disk_2_5":        "512 GB",
+		"ip_address_1_5":  "10.0.0.1",
+		"environment_5":   "production",
+		"rack_position_3": "U12",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("freshclient.ExpandTypeFields() = %v, want %v", got, want)
+	}
+}
+
+// TestLookupTypeField tests that plain names ending in _<digits> are not
+// mistaken for keys with an asset type ID suffix.
+func TestLookupTypeField(t *testing.T) {
+	typeFields := map[string]interface{}{
+		"disk_50000240147":   "1 TB",
+		"disk_2_50000240147": "512 GB",
+		"vendor_3":           "Dell",
+	}
+	tests := map[string]interface{}{
+		"disk":               "1 TB",
+		"disk_2":             "512 GB",
+		"disk_2_50000240147": "512 GB",
+		"vendor":             "Dell",
+		"vendor_3":           "Dell",
+		"disk_3":             nil,
+	}
+
+	for name, want := range tests {
+		if got, _ := LookupTypeField(typeFields, name); got != want {
+			t.Errorf("freshclient.LookupTypeField(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+// TestPlainName tests that only the ID of the asset type defining a field is
+// removed from its name.
+func TestPlainName(t *testing.T) {
+	tests := map[string]AssetTypeFieldDetails{
+		"disk_2":        {AssetTypeID: 50000240147, Name: "disk_2_50000240147"},
+		"serial_number": {AssetTypeID: 3, Name: "serial_number_3"},
+		"name":          {Name: "name"},
+	}
+
+	for want, field := range tests {
+		if got := field.PlainName(); got != want {
+			t.Errorf("AssetTypeFieldDetails.PlainName() = %v, want %v", got, want)
+		}
+	}
+}
+
+// TestAssetTypeFields tests that type fields are sent and read back.
+func TestAssetTypeFields(t *testing.T) {
+	client, server := newFakeClient(t)
+	server.AddAssetTypeField(1, "serial_number", "Serial Number", "text")
+	ctx := context.Background()
+
+	created, err := client.CreateAsset(ctx, AssetDetails{
+		Name:        "TestGolangAsset",
+		AssetTypeID: 1,
+		TypeFields:  ExpandTypeFields(map[string]interface{}{"serial_number": "SN-1"}, 1, nil),
+	})
+	if err != nil {
+		t.Errorf("freshclient.CreateAsset() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	asset, err := client.GetAsset(ctx, created.DisplayID)
+	if err != nil {
+		t.Errorf("freshclient.GetAsset() error = %v, want %v", err, nil)
+		t.FailNow()
+	}
+
+	if got := TrimTypeFields(asset.TypeFields)["serial_number"]; got != "SN-1" {
+		t.Errorf("freshclient.GetAsset() serial_number = %v, want %v", got, "SN-1")
+	}
+}
@@ -0,0 +1,326 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strings"
+)
+
+// agentScopes are the accepted assignment scopes of agent roles.
+var agentScopes = []string{"entire_helpdesk", "member_groups", "specified_groups", "assigned_items"}
+
+// agentStates are the accepted values of the state filter of the agent list.
+var agentStates = []string{"fulltime", "occasional"}
+
+func (s *Server) registerAgents() {
+	s.handle("GET", "agents", s.listAgents)
+	s.handle("POST", "agents", s.createAgent)
+	s.handle("GET", "agents/*", s.getAgent)
+	s.handle("PUT", "agents/*", s.updateAgent)
+	s.handle("DELETE", "agents/*", s.deactivateAgent)
+	s.handle("DELETE", "agents/*/forget", s.forgetAgent)
+	s.handle("PUT", "agents/*/reactivate", s.reactivateAgent)
+}
+
+// AddAgent stores an active fulltime agent without roles and returns its ID.
+func (s *Server) AddAgent(firstName string, email string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	agents := s.store("agents")
+	return agents.insert(Object{
+		"id":                   agents.nextID,
+		"first_name":           firstName,
+		"last_name":            nil,
+		"email":                email,
+		"job_title":            nil,
+		"work_phone_number":    nil,
+		"mobile_phone_number":  nil,
+		"occasional":           false,
+		"active":               true,
+		"department_ids":       []interface{}{},
+		"location_id":          nil,
+		"reporting_manager_id": nil,
+		"roles":                []interface{}{},
+		"created_at":           now(),
+		"updated_at":           now(),
+	})
+}
+
+// agentResponse returns an agent with the agent groups it is a member and an
+// observer of, the memberships are stored on the groups.
+func (s *Server) agentResponse(agent Object) Object {
+	id, _ := toInt64(agent["id"])
+	response := copyObject(agent)
+	response["member_of"] = []interface{}{}
+	response["observer_of"] = []interface{}{}
+	for _, group := range s.store("groups").sorted() {
+		for _, field := range []string{"member", "observer"} {
+			if containsID(group[field+"s"], id) {
+				response[field+"_of"] = append(response[field+"_of"].([]interface{}), group["id"])
+			}
+		}
+	}
+
+	return response
+}
+
+// setAgentGroups makes the agent a member or observer of exactly the groups
+// listed in the member_of and observer_of fields of update, missing fields
+// are left alone.
+func (s *Server) setAgentGroups(id int64, update Object) {
+	for _, field := range []string{"member", "observer"} {
+		groupIDs, ok := update[field+"_of"]
+		if !ok {
+			continue
+		}
+		for groupID, group := range s.store("groups").objects {
+			ids := []interface{}{}
+			for _, element := range group[field+"s"].([]interface{}) {
+				if elementID, _ := toInt64(element); elementID != id {
+					ids = append(ids, element)
+				}
+			}
+			if containsID(groupIDs, groupID) {
+				ids = append(ids, id)
+			}
+			group[field+"s"] = ids
+		}
+	}
+}
+
+// containsID reports whether the list of IDs contains id.
+func containsID(list interface{}, id int64) bool {
+	elements, _ := list.([]interface{})
+	for _, element := range elements {
+		if elementID, _ := toInt64(element); elementID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listAgents lists the agents matching the email, state and active query
+// parameters.
+func (s *Server) listAgents(w http.ResponseWriter, r *http.Request, params []string) {
+	query := r.URL.Query()
+	state := query.Get("state")
+	if state != "" && !oneOf(state, agentStates) {
+		writeError(w, http.StatusBadRequest, "state should be one of these values: '"+strings.Join(agentStates, ",")+"'")
+		return
+	}
+
+	agents := []Object{}
+	for _, agent := range s.store("agents").sorted() {
+		if email, _ := agent["email"].(string); query.Get("email") != "" && !strings.EqualFold(email, query.Get("email")) {
+			continue
+		}
+		if state != "" && agent["occasional"] != (state == "occasional") {
+			continue
+		}
+		if active := query.Get("active"); active != "" && agent["active"] != (active == "true") {
+			continue
+		}
+		agents = append(agents, s.agentResponse(agent))
+	}
+
+	writePage(w, r, "agents", agents)
+}
+
+func (s *Server) createAgent(w http.ResponseWriter, r *http.Request, params []string) {
+	agent, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	stored := Object{
+		"last_name":            nil,
+		"job_title":            nil,
+		"work_phone_number":    nil,
+		"mobile_phone_number":  nil,
+		"occasional":           false,
+		"department_ids":       []interface{}{},
+		"location_id":          nil,
+		"reporting_manager_id": nil,
+	}
+	merge(stored, agent)
+	if errors := s.validateAgent(0, stored); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	agents := s.store("agents")
+	id := agents.nextID
+	delete(stored, "member_of")
+	delete(stored, "observer_of")
+	stored["id"] = id
+	stored["active"] = true
+	stored["created_at"] = now()
+	stored["updated_at"] = now()
+	agents.insert(stored)
+	s.setAgentGroups(id, agent)
+
+	writeJSON(w, http.StatusCreated, Object{"agent": s.agentResponse(stored)})
+}
+
+func (s *Server) getAgent(w http.ResponseWriter, r *http.Request, params []string) {
+	agent, ok := s.findAgent(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"agent": s.agentResponse(agent)})
+}
+
+func (s *Server) updateAgent(w http.ResponseWriter, r *http.Request, params []string) {
+	agent, ok := s.findAgent(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(agent["id"])
+	updated := copyObject(agent)
+	merge(updated, update)
+	if errors := s.validateAgent(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	merge(agent, update)
+	delete(agent, "member_of")
+	delete(agent, "observer_of")
+	agent["updated_at"] = now()
+	s.setAgentGroups(id, update)
+	writeJSON(w, http.StatusOK, Object{"agent": s.agentResponse(agent)})
+}
+
+// deactivateAgent deactivates an agent, the agent is kept and its group
+// memberships are removed.
+func (s *Server) deactivateAgent(w http.ResponseWriter, r *http.Request, params []string) {
+	agent, ok := s.findAgent(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(agent["id"])
+	agent["active"] = false
+	agent["updated_at"] = now()
+	s.setAgentGroups(id, Object{"member_of": []interface{}{}, "observer_of": []interface{}{}})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reactivateAgent reactivates a deactivated agent, the group memberships
+// removed on deactivation are not restored.
+func (s *Server) reactivateAgent(w http.ResponseWriter, r *http.Request, params []string) {
+	agent, ok := s.findAgent(w, params[0])
+	if !ok {
+		return
+	}
+
+	if agent["active"] == true {
+		writeError(w, http.StatusBadRequest, "Agent is already active")
+		return
+	}
+
+	agent["active"] = true
+	agent["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"agent": s.agentResponse(agent)})
+}
+
+// forgetAgent permanently deletes an agent.
+func (s *Server) forgetAgent(w http.ResponseWriter, r *http.Request, params []string) {
+	agent, ok := s.findAgent(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(agent["id"])
+	s.setAgentGroups(id, Object{"member_of": []interface{}{}, "observer_of": []interface{}{}})
+	delete(s.store("agents").objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateAgent returns the field errors of an agent create or update, id is
+// the agent being updated or 0 on create. Email addresses are unique
+// regardless of case, every agent needs a role and group memberships must
+// refer to existing agent groups.
+func (s *Server) validateAgent(id int64, agent Object) []fieldError {
+	var errors []fieldError
+
+	if firstName, _ := agent["first_name"].(string); strings.TrimSpace(firstName) == "" {
+		errors = append(errors, fieldError{Field: "first_name", Message: "It should not be blank", Code: "missing_field"})
+	}
+
+	email, _ := agent["email"].(string)
+	if !strings.Contains(email, "@") {
+		errors = append(errors, fieldError{Field: "email", Message: "It should be in the 'valid email address' format", Code: "invalid_value"})
+	}
+	for otherID, other := range s.store("agents").objects {
+		if otherEmail, _ := other["email"].(string); otherID != id && strings.EqualFold(otherEmail, email) {
+			errors = append(errors, fieldError{Field: "email", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	roles, _ := agent["roles"].([]interface{})
+	if len(roles) == 0 {
+		errors = append(errors, fieldError{Field: "roles", Message: "It should contain at least one role", Code: "missing_field"})
+	}
+	for _, element := range roles {
+		role, _ := element.(Object)
+		if roleID, ok := toInt64(role["role_id"]); !ok || roleID <= 0 {
+			errors = append(errors, fieldError{Field: "role_id", Message: "It should be a valid role", Code: "invalid_value"})
+		}
+		if !oneOf(role["assignment_scope"], agentScopes) {
+			errors = append(errors, fieldError{Field: "assignment_scope", Message: "It should be one of these values: '" + strings.Join(agentScopes, ",") + "'", Code: "invalid_value"})
+		}
+		groups, _ := role["groups"].([]interface{})
+		if role["assignment_scope"] == "specified_groups" && len(groups) == 0 {
+			errors = append(errors, fieldError{Field: "groups", Message: "It should not be blank for specified_groups", Code: "missing_field"})
+		}
+	}
+
+	if !isIDList(agent["department_ids"]) {
+		errors = append(errors, fieldError{Field: "department_ids", Message: "It should be an array of integers", Code: "datatype_mismatch"})
+	}
+
+	for _, field := range []string{"member_of", "observer_of"} {
+		groupIDs, ok := agent[field]
+		if !ok {
+			continue
+		}
+		if !isIDList(groupIDs) {
+			errors = append(errors, fieldError{Field: field, Message: "It should be an array of integers", Code: "datatype_mismatch"})
+			continue
+		}
+		for _, element := range groupIDs.([]interface{}) {
+			groupID, _ := toInt64(element)
+			if _, ok := s.store("groups").objects[groupID]; !ok {
+				errors = append(errors, fieldError{Field: field, Message: "It should be a list of valid agent groups", Code: "invalid_value"})
+				break
+			}
+		}
+	}
+
+	return errors
+}
+
+// findAgent looks up an agent by the ID path parameter.
+func (s *Server) findAgent(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	agent, ok := s.store("agents").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return agent, true
+}
@@ -0,0 +1,355 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	applicationTypes    = []string{"desktop", "saas", "mobile"}
+	applicationStatuses = []string{"blacklisted", "ignored", "managed"}
+)
+
+func (s *Server) registerApplications() {
+	s.handle("GET", "applications", s.listApplications)
+	s.handle("POST", "applications", s.createApplication)
+	s.handle("GET", "applications/*", s.getApplication)
+	s.handle("PUT", "applications/*", s.updateApplication)
+	s.handle("DELETE", "applications/*", s.deleteApplication)
+	s.handle("GET", "applications/*/installations", s.listInstallations)
+	s.handle("POST", "applications/*/installations", s.createInstallation)
+	s.handle("DELETE", "applications/*/installations", s.deleteInstallations)
+	s.handle("GET", "assets/*/applications", s.listAssetApplications)
+}
+
+// AddApplication stores a desktop application and returns its ID.
+func (s *Server) AddApplication(name string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	applications := s.store("applications")
+	return applications.insert(Object{
+		"id":               applications.nextID,
+		"name":             name,
+		"description":      "",
+		"application_type": "desktop",
+		"status":           "managed",
+		"notes":            "",
+		"category":         "",
+		"source":           "API",
+		"user_count":       0,
+		"created_at":       now(),
+		"updated_at":       now(),
+	})
+}
+
+// AddInstallation records an installation of an application on an asset and
+// returns its ID.
+func (s *Server) AddInstallation(applicationID int64, displayID int64, version string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	installations := s.store("installations")
+	return installations.insert(Object{
+		"id":                      installations.nextID,
+		"application_id":          applicationID,
+		"installation_machine_id": displayID,
+		"version":                 version,
+		"created_at":              now(),
+		"updated_at":              now(),
+	})
+}
+
+// removeAssetInstallations deletes the installations on a deleted asset.
+func (s *Server) removeAssetInstallations(displayID int64) {
+	installations := s.store("installations")
+	for id, installation := range installations.objects {
+		if machineID, _ := toInt64(installation["installation_machine_id"]); machineID == displayID {
+			delete(installations.objects, id)
+		}
+	}
+}
+
+// applicationInstallations returns the installations of an application in
+// ID order.
+func (s *Server) applicationInstallations(applicationID int64) []Object {
+	installations := []Object{}
+	for _, installation := range s.store("installations").sorted() {
+		if id, _ := toInt64(installation["application_id"]); id == applicationID {
+			installations = append(installations, installation)
+		}
+	}
+
+	return installations
+}
+
+// applicationResponse returns an application with its installation count.
+func (s *Server) applicationResponse(application Object) Object {
+	id, _ := toInt64(application["id"])
+	response := copyObject(application)
+	response["installation_count"] = len(s.applicationInstallations(id))
+
+	return response
+}
+
+// installationResponse returns an installation without the internal
+// application ID.
+func installationResponse(installation Object) Object {
+	response := copyObject(installation)
+	delete(response, "application_id")
+
+	return response
+}
+
+func (s *Server) listApplications(w http.ResponseWriter, r *http.Request, params []string) {
+	applications := []Object{}
+	for _, application := range s.store("applications").sorted() {
+		applications = append(applications, s.applicationResponse(application))
+	}
+
+	writePage(w, r, "applications", applications)
+}
+
+func (s *Server) createApplication(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := application["application_type"]; !ok {
+		application["application_type"] = "desktop"
+	}
+	if _, ok := application["status"]; !ok {
+		application["status"] = "managed"
+	}
+	if errors := s.validateApplication(0, application); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	applications := s.store("applications")
+	application["id"] = applications.nextID
+	application["source"] = "API"
+	application["user_count"] = 0
+	application["created_at"] = now()
+	application["updated_at"] = now()
+	applications.insert(application)
+
+	writeJSON(w, http.StatusCreated, Object{"application": s.applicationResponse(application)})
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := s.findApplication(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"application": s.applicationResponse(application)})
+}
+
+func (s *Server) updateApplication(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := s.findApplication(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(application["id"])
+	updated := copyObject(application)
+	merge(updated, update)
+	if errors := s.validateApplication(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	merge(application, update)
+	application["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"application": s.applicationResponse(application)})
+}
+
+// deleteApplication deletes an application together with its installations.
+func (s *Server) deleteApplication(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := s.findApplication(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(application["id"])
+	installations := s.store("installations")
+	for _, installation := range s.applicationInstallations(id) {
+		installationID, _ := toInt64(installation["id"])
+		delete(installations.objects, installationID)
+	}
+
+	delete(s.store("applications").objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateApplication returns the field errors of an application create or
+// update, id is the application being updated or 0 on create.
+func (s *Server) validateApplication(id int64, application Object) []fieldError {
+	var errors []fieldError
+
+	name, _ := application["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+	for otherID, other := range s.store("applications").objects {
+		if otherID != id && other["name"] == name {
+			errors = append(errors, fieldError{Field: "name", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	if !oneOf(application["application_type"], applicationTypes) {
+		errors = append(errors, fieldError{Field: "application_type", Message: "It should be one of these values: '" + strings.Join(applicationTypes, ",") + "'", Code: "invalid_value"})
+	}
+	if !oneOf(application["status"], applicationStatuses) {
+		errors = append(errors, fieldError{Field: "status", Message: "It should be one of these values: '" + strings.Join(applicationStatuses, ",") + "'", Code: "invalid_value"})
+	}
+
+	return errors
+}
+
+// oneOf reports whether value is one of the allowed strings.
+func oneOf(value interface{}, allowed []string) bool {
+	s, _ := value.(string)
+	for _, a := range allowed {
+		if s == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findApplication looks up an application by the ID path parameter.
+func (s *Server) findApplication(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	application, ok := s.store("applications").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return application, true
+}
+
+func (s *Server) listInstallations(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := s.findApplication(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(application["id"])
+	installations := []Object{}
+	for _, installation := range s.applicationInstallations(id) {
+		installations = append(installations, installationResponse(installation))
+	}
+
+	writePage(w, r, "installations", installations)
+}
+
+// createInstallation records an installation, an application can be
+// installed on an asset only once.
+func (s *Server) createInstallation(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := s.findApplication(w, params[0])
+	if !ok {
+		return
+	}
+
+	installation, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(application["id"])
+	machineID, _ := toInt64(installation["installation_machine_id"])
+	if _, ok := s.store("assets").objects[machineID]; !ok {
+		writeValidationError(w, []fieldError{{Field: "installation_machine_id", Message: "It should be a valid asset", Code: "invalid_value"}})
+		return
+	}
+	for _, other := range s.applicationInstallations(id) {
+		if otherMachineID, _ := toInt64(other["installation_machine_id"]); otherMachineID == machineID {
+			writeValidationError(w, []fieldError{{Field: "installation_machine_id", Message: "It should be a unique value", Code: "duplicate_value"}})
+			return
+		}
+	}
+
+	installations := s.store("installations")
+	installation["id"] = installations.nextID
+	installation["application_id"] = id
+	installation["installation_machine_id"] = machineID
+	installation["created_at"] = now()
+	installation["updated_at"] = now()
+	installations.insert(installation)
+
+	writeJSON(w, http.StatusCreated, Object{"installation": installationResponse(installation)})
+}
+
+// deleteInstallations removes the installations on the assets listed in the
+// device_ids query parameter.
+func (s *Server) deleteInstallations(w http.ResponseWriter, r *http.Request, params []string) {
+	application, ok := s.findApplication(w, params[0])
+	if !ok {
+		return
+	}
+
+	machineIDs := map[int64]bool{}
+	for _, value := range strings.Split(r.URL.Query().Get("device_ids"), ",") {
+		machineID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "device_ids should be a comma separated list of IDs")
+			return
+		}
+		machineIDs[machineID] = true
+	}
+
+	id, _ := toInt64(application["id"])
+	installations := s.store("installations")
+	deleted := 0
+	for _, installation := range s.applicationInstallations(id) {
+		if machineID, _ := toInt64(installation["installation_machine_id"]); machineIDs[machineID] {
+			installationID, _ := toInt64(installation["id"])
+			delete(installations.objects, installationID)
+			deleted++
+		}
+	}
+
+	if deleted == 0 {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listAssetApplications lists the applications installed on an asset.
+func (s *Server) listAssetApplications(w http.ResponseWriter, r *http.Request, params []string) {
+	asset, ok := s.findAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	displayID, _ := toInt64(asset["display_id"])
+	applications := []Object{}
+	for _, application := range s.store("applications").sorted() {
+		id, _ := toInt64(application["id"])
+		for _, installation := range s.applicationInstallations(id) {
+			if machineID, _ := toInt64(installation["installation_machine_id"]); machineID == displayID {
+				applications = append(applications, s.applicationResponse(application))
+				break
+			}
+		}
+	}
+
+	writePage(w, r, "applications", applications)
+}
@@ -0,0 +1,293 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strconv"
+)
+
+func (s *Server) registerAssetTypes() {
+	s.handle("GET", "asset_types", s.listAssetTypes)
+	s.handle("POST", "asset_types", s.createAssetType)
+	s.handle("GET", "asset_types/*", s.getAssetType)
+	s.handle("PUT", "asset_types/*", s.updateAssetType)
+	s.handle("DELETE", "asset_types/*", s.deleteAssetType)
+	s.handle("GET", "asset_types/*/fields", s.getAssetTypeFields)
+}
+
+// AddAssetType stores an asset type and returns its ID, a parentID of 0
+// creates a root type.
+func (s *Server) AddAssetType(name string, parentID int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assetType := Object{
+		"name":                 name,
+		"description":          "",
+		"parent_asset_type_id": nil,
+		"visible":              true,
+		"created_at":           now(),
+		"updated_at":           now(),
+	}
+	if parentID != 0 {
+		assetType["parent_asset_type_id"] = parentID
+	}
+
+	assetTypes := s.store("asset_types")
+	assetType["id"] = assetTypes.nextID
+
+	return assetTypes.insert(assetType)
+}
+
+// AddAssetTypeField defines a type field on an asset type and returns the
+// field key, which carries the asset type ID as suffix like the real API.
+func (s *Server) AddAssetTypeField(assetTypeID int64, name string, label string, dataType string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := name + "_" + strconv.FormatInt(assetTypeID, 10)
+	s.assetTypeFields[assetTypeID] = append(s.assetTypeFields[assetTypeID], Object{
+		"id":            int64(len(s.assetTypeFields[assetTypeID]) + 1),
+		"asset_type_id": assetTypeID,
+		"name":          key,
+		"label":         label,
+		"field_type":    "custom_" + dataType,
+		"data_type":     dataType,
+		"mandatory":     false,
+		"choices":       []interface{}{},
+	})
+
+	return key
+}
+
+// SetAssetTypeFieldChoices turns a type field into a dropdown accepting the
+// given values, choices are sent as [value, id] pairs like the real API.
+func (s *Server) SetAssetTypeFieldChoices(assetTypeID int64, key string, values ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	choices := make([]interface{}, 0, len(values))
+	for i, value := range values {
+		choices = append(choices, []interface{}{value, i + 1})
+	}
+
+	for _, field := range s.assetTypeFields[assetTypeID] {
+		if field["name"] == key {
+			field["data_type"] = "dropdown"
+			field["field_type"] = "custom_dropdown"
+			field["choices"] = choices
+		}
+	}
+}
+
+// defaultAssetFields are the fields every asset type has, they have no asset
+// type ID.
+var defaultAssetFields = []Object{
+	{"id": 1, "asset_type_id": nil, "name": "name", "label": "Display Name", "field_type": "default_name", "data_type": "text", "mandatory": true, "choices": []interface{}{}},
+	{"id": 2, "asset_type_id": nil, "name": "asset_type_id", "label": "Asset Type", "field_type": "default_asset_type", "data_type": "dropdown", "mandatory": true, "choices": []interface{}{}},
+	{"id": 3, "asset_type_id": nil, "name": "asset_tag", "label": "Asset Tag", "field_type": "default_asset_tag", "data_type": "text", "mandatory": false, "choices": []interface{}{}},
+	{"id": 4, "asset_type_id": nil, "name": "impact", "label": "Impact", "field_type": "default_impact", "data_type": "dropdown", "mandatory": false, "choices": []interface{}{[]interface{}{"low", 1}, []interface{}{"medium", 2}, []interface{}{"high", 3}}},
+}
+
+// typeFieldKeys returns the keys of the type fields of an asset type,
+// including the fields inherited from its parents.
+func (s *Server) typeFieldKeys(assetTypeID int64) []string {
+	var keys []string
+	for _, field := range s.typeFields(assetTypeID) {
+		keys = append(keys, field["name"].(string))
+	}
+
+	return keys
+}
+
+// typeFields returns the type fields of an asset type followed by the fields
+// inherited from its parents.
+func (s *Server) typeFields(assetTypeID int64) []Object {
+	var fields []Object
+	for assetTypeID != 0 {
+		fields = append(fields, s.assetTypeFields[assetTypeID]...)
+
+		assetType, ok := s.store("asset_types").objects[assetTypeID]
+		if !ok {
+			break
+		}
+		assetTypeID, _ = toInt64(assetType["parent_asset_type_id"])
+	}
+
+	return fields
+}
+
+// getAssetTypeFields lists the default fields followed by the type fields of
+// the asset type and its parents, grouped by the asset type defining them.
+func (s *Server) getAssetTypeFields(w http.ResponseWriter, r *http.Request, params []string) {
+	assetType, ok := s.findAssetType(w, params[0])
+	if !ok {
+		return
+	}
+
+	var lineage []Object
+	for assetType != nil {
+		lineage = append([]Object{assetType}, lineage...)
+		parentID, _ := toInt64(assetType["parent_asset_type_id"])
+		assetType = s.store("asset_types").objects[parentID]
+	}
+
+	groups := []Object{{"id": 1, "field_header": "General", "fields": defaultAssetFields}}
+	for _, assetType := range lineage {
+		id, _ := toInt64(assetType["id"])
+		fields := s.assetTypeFields[id]
+		if len(fields) == 0 {
+			continue
+		}
+		groups = append(groups, Object{
+			"id":           int64(len(groups) + 1),
+			"field_header": assetType["name"],
+			"fields":       fields,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, Object{"asset_type_fields": groups})
+}
+
+func (s *Server) listAssetTypes(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "asset_types", s.store("asset_types").sorted())
+}
+
+func (s *Server) createAssetType(w http.ResponseWriter, r *http.Request, params []string) {
+	body, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	assetType := Object{
+		"description":          "",
+		"parent_asset_type_id": nil,
+		"visible":              true,
+	}
+	merge(assetType, body)
+	if errors := s.validateAssetType(0, assetType); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	assetTypes := s.store("asset_types")
+	assetType["id"] = assetTypes.nextID
+	assetType["created_at"] = now()
+	assetType["updated_at"] = now()
+	assetTypes.insert(assetType)
+
+	writeJSON(w, http.StatusCreated, Object{"asset_type": assetType})
+}
+
+func (s *Server) getAssetType(w http.ResponseWriter, r *http.Request, params []string) {
+	assetType, ok := s.findAssetType(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"asset_type": assetType})
+}
+
+// updateAssetType changes an asset type, like the real API the parent cannot
+// be changed.
+func (s *Server) updateAssetType(w http.ResponseWriter, r *http.Request, params []string) {
+	assetType, ok := s.findAssetType(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := update["parent_asset_type_id"]; ok {
+		writeValidationError(w, []fieldError{{Field: "parent_asset_type_id", Message: "Unexpected/invalid field in request", Code: "invalid_field"}})
+		return
+	}
+
+	id, _ := toInt64(assetType["id"])
+	updated := copyObject(assetType)
+	merge(updated, update)
+	if errors := s.validateAssetType(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	merge(assetType, update)
+	assetType["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"asset_type": assetType})
+}
+
+// deleteAssetType deletes an asset type, types that still have child types
+// or assets cannot be deleted.
+func (s *Server) deleteAssetType(w http.ResponseWriter, r *http.Request, params []string) {
+	assetType, ok := s.findAssetType(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(assetType["id"])
+	for _, other := range s.store("asset_types").objects {
+		if parentID, _ := toInt64(other["parent_asset_type_id"]); parentID == id {
+			writeError(w, http.StatusBadRequest, "Asset type has child asset types")
+			return
+		}
+	}
+	for _, asset := range s.store("assets").objects {
+		if assetTypeID, _ := toInt64(asset["asset_type_id"]); assetTypeID == id {
+			writeError(w, http.StatusBadRequest, "Asset type is in use by assets")
+			return
+		}
+	}
+
+	delete(s.store("asset_types").objects, id)
+	delete(s.assetTypeFields, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateAssetType returns the field errors of an asset type create or
+// update, id is the asset type being updated or 0 on create. Names only have
+// to be unique among the children of the same parent.
+func (s *Server) validateAssetType(id int64, assetType Object) []fieldError {
+	var errors []fieldError
+
+	name, _ := assetType["name"].(string)
+	if name == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+	parentID, _ := toInt64(assetType["parent_asset_type_id"])
+	for otherID, other := range s.store("asset_types").objects {
+		otherParentID, _ := toInt64(other["parent_asset_type_id"])
+		if otherID != id && otherParentID == parentID && other["name"] == name {
+			errors = append(errors, fieldError{Field: "name", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	if assetType["parent_asset_type_id"] != nil {
+		if _, ok := s.store("asset_types").objects[parentID]; !ok {
+			errors = append(errors, fieldError{Field: "parent_asset_type_id", Message: "It should be a valid asset type", Code: "invalid_value"})
+		}
+	}
+
+	if _, ok := assetType["visible"].(bool); !ok {
+		errors = append(errors, fieldError{Field: "visible", Message: "It should be a Boolean value", Code: "datatype_mismatch"})
+	}
+
+	return errors
+}
+
+// findAssetType looks up an asset type by the ID path parameter.
+func (s *Server) findAssetType(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	assetType, ok := s.store("asset_types").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return assetType, true
+}
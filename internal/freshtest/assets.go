@@ -0,0 +1,381 @@
+package freshtest
+
+import (
+	"encoding/json"
+	http "net/http"
+	"strings"
+)
+
+// assetIDOffset separates the internal asset ID from its display ID.
+const assetIDOffset = 50000000000
+
+func (s *Server) registerAssets() {
+	s.handle("GET", "assets", s.listAssets)
+	s.handle("POST", "assets", s.createAsset)
+	s.handle("GET", "assets/*", s.getAsset)
+	s.handle("PUT", "assets/*", s.updateAsset)
+	s.handle("DELETE", "assets/*", s.deleteAsset)
+	s.handle("PUT", "assets/*/delete_forever", s.deleteAssetForever)
+	s.handle("PUT", "assets/*/restore", s.restoreAsset)
+}
+
+// TrashedAsset returns a copy of the trashed asset with the given display ID or nil.
+func (s *Server) TrashedAsset(displayID int64) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	asset, ok := s.store("assets").trash[displayID]
+	if !ok {
+		return nil
+	}
+
+	return copyObject(asset)
+}
+
+// AddAsset stores an asset directly and returns its display ID.
+func (s *Server) AddAsset(asset Object) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.insertAsset(copyObject(asset))
+}
+
+// Asset returns a copy of the asset with the given display ID or nil.
+func (s *Server) Asset(displayID int64) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	asset, ok := s.store("assets").objects[displayID]
+	if !ok {
+		return nil
+	}
+
+	return copyObject(asset)
+}
+
+// UpdateAsset changes fields of an asset out of band, e.g. to simulate drift.
+func (s *Server) UpdateAsset(displayID int64, update Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if asset, ok := s.store("assets").objects[displayID]; ok {
+		mergeAsset(asset, update)
+		asset["updated_at"] = now()
+	}
+}
+
+// RemoveAsset deletes an asset out of band without moving it to the trash.
+func (s *Server) RemoveAsset(displayID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assets := s.store("assets")
+	delete(assets.objects, displayID)
+	delete(assets.trash, displayID)
+	s.removeAssetRelationships(displayID)
+	s.removeAssetInstallations(displayID)
+}
+
+func (s *Server) insertAsset(asset Object) int64 {
+	assets := s.store("assets")
+	displayID := assets.nextID
+	asset["id"] = assetIDOffset + displayID
+	asset["display_id"] = displayID
+	asset["author_type"] = "User"
+	if _, ok := asset["impact"]; !ok {
+		asset["impact"] = "low"
+	}
+	if _, ok := asset["usage_type"]; !ok {
+		asset["usage_type"] = "permanent"
+	}
+	asset["created_at"] = now()
+	asset["updated_at"] = now()
+
+	return assets.insert(asset)
+}
+
+// validateAsset returns the field errors of an asset create or update.
+func (s *Server) validateAsset(asset Object) []fieldError {
+	var errors []fieldError
+
+	if name, _ := asset["name"].(string); name == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+
+	assetTypeID, _ := toInt64(asset["asset_type_id"])
+	if _, ok := s.store("asset_types").objects[assetTypeID]; !ok {
+		errors = append(errors, fieldError{Field: "asset_type_id", Message: "It should be a valid asset type", Code: "invalid_value"})
+	}
+
+	if impact, ok := asset["impact"].(string); ok {
+		switch impact {
+		case "low", "medium", "high":
+		default:
+			errors = append(errors, fieldError{Field: "impact", Message: "It should be one of these values: 'low,medium,high'", Code: "invalid_value"})
+		}
+	}
+
+	dataTypes := map[string]string{}
+	for _, field := range s.typeFields(assetTypeID) {
+		dataTypes[field["name"].(string)] = field["data_type"].(string)
+	}
+	typeFields, _ := asset["type_fields"].(Object)
+	for key, value := range typeFields {
+		dataType, ok := dataTypes[key]
+		if !ok {
+			errors = append(errors, fieldError{Field: key, Message: "Unexpected/invalid field in request", Code: "invalid_field"})
+			continue
+		}
+		if message := typeFieldMismatch(dataType, value); message != "" {
+			errors = append(errors, fieldError{Field: key, Message: message, Code: "datatype_mismatch"})
+		}
+	}
+
+	if usageType, ok := asset["usage_type"].(string); ok {
+		switch usageType {
+		case "permanent", "loaner":
+		default:
+			errors = append(errors, fieldError{Field: "usage_type", Message: "It should be one of these values: 'permanent,loaner'", Code: "invalid_value"})
+		}
+	}
+
+	return errors
+}
+
+// typeFieldMismatch returns the validation message for a type field value
+// that does not match the data type of the field, values may be null.
+func typeFieldMismatch(dataType string, value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	switch dataType {
+	case "number":
+		if number, ok := value.(json.Number); !ok || strings.ContainsAny(number.String(), ".eE") {
+			return "It should be a/an Integer"
+		}
+	case "decimal":
+		if _, ok := value.(json.Number); !ok {
+			return "It should be a/an Number"
+		}
+	case "checkbox":
+		if _, ok := value.(bool); !ok {
+			return "It should be a/an Boolean"
+		}
+	default:
+		if _, ok := value.(string); !ok {
+			return "It should be a/an String"
+		}
+	}
+
+	return ""
+}
+
+// assetResponse returns the asset as sent by the API, type fields are only
+// included when requested and then list every field of the asset type.
+func (s *Server) assetResponse(asset Object, includeTypeFields bool) Object {
+	response := copyObject(asset)
+	delete(response, "type_fields")
+	if !includeTypeFields {
+		return response
+	}
+
+	assetTypeID, _ := toInt64(asset["asset_type_id"])
+	stored, _ := asset["type_fields"].(Object)
+	typeFields := Object{}
+	for _, key := range s.typeFieldKeys(assetTypeID) {
+		typeFields[key] = stored[key]
+	}
+	response["type_fields"] = typeFields
+
+	return response
+}
+
+// includesTypeFields reports whether the request asks for type fields.
+func includesTypeFields(r *http.Request) bool {
+	return r.URL.Query().Get("include") == "type_fields"
+}
+
+func (s *Server) listAssets(w http.ResponseWriter, r *http.Request, params []string) {
+	query := r.URL.Query()
+	objects := s.store("assets").sorted()
+	if query.Get("trashed") == "true" {
+		objects = s.store("assets").sortedTrash()
+	}
+
+	matches := func(Object) bool { return true }
+	var err error
+	switch {
+	case query.Get("filter") != "" && query.Get("search") != "":
+		writeError(w, http.StatusBadRequest, "filter and search cannot be combined")
+		return
+	case query.Get("filter") != "":
+		matches, err = parseFilter(query.Get("filter"))
+	case query.Get("search") != "":
+		matches, err = parseSearch(query.Get("search"))
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	assets := []Object{}
+	for _, asset := range objects {
+		if matches(asset) {
+			assets = append(assets, s.assetResponse(asset, includesTypeFields(r)))
+		}
+	}
+
+	writePage(w, r, "assets", assets)
+}
+
+func (s *Server) createAsset(w http.ResponseWriter, r *http.Request, params []string) {
+	asset, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if errors := s.validateAsset(asset); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	s.insertAsset(asset)
+	writeJSON(w, http.StatusCreated, Object{"asset": s.assetResponse(asset, true)})
+}
+
+func (s *Server) getAsset(w http.ResponseWriter, r *http.Request, params []string) {
+	asset, ok := s.findAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"asset": s.assetResponse(asset, includesTypeFields(r))})
+}
+
+func (s *Server) updateAsset(w http.ResponseWriter, r *http.Request, params []string) {
+	asset, ok := s.findAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	updated := copyObject(asset)
+	mergeAsset(updated, update)
+	if errors := s.validateAsset(updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	mergeAsset(asset, update)
+	asset["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"asset": s.assetResponse(asset, true)})
+}
+
+// mergeAsset applies an update to an asset, type fields are merged one by one.
+func mergeAsset(asset Object, update Object) {
+	typeFields := Object{}
+	if stored, ok := asset["type_fields"].(Object); ok {
+		merge(typeFields, stored)
+	}
+	if changed, ok := update["type_fields"].(Object); ok {
+		merge(typeFields, changed)
+	}
+
+	merge(asset, update)
+	asset["type_fields"] = typeFields
+}
+
+// deleteAsset moves an asset to the trash like the real API does.
+func (s *Server) deleteAsset(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.findAsset(w, params[0]); !ok {
+		return
+	}
+
+	assets := s.store("assets")
+	displayID, _ := parseID(w, params[0])
+	assets.trash[displayID] = assets.objects[displayID]
+	delete(assets.objects, displayID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteAssetForever permanently deletes an asset, only trashed assets can
+// be deleted this way.
+func (s *Server) deleteAssetForever(w http.ResponseWriter, r *http.Request, params []string) {
+	displayID, ok := s.findTrashedAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	delete(s.store("assets").trash, displayID)
+	s.removeAssetRelationships(displayID)
+	s.removeAssetInstallations(displayID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// restoreAsset moves an asset out of the trash.
+func (s *Server) restoreAsset(w http.ResponseWriter, r *http.Request, params []string) {
+	displayID, ok := s.findTrashedAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	assets := s.store("assets")
+	assets.objects[displayID] = assets.trash[displayID]
+	delete(assets.trash, displayID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findTrashedAsset looks up a trashed asset by the display ID path parameter.
+func (s *Server) findTrashedAsset(w http.ResponseWriter, param string) (int64, bool) {
+	displayID, ok := parseID(w, param)
+	if !ok {
+		return 0, false
+	}
+
+	if _, ok := s.store("assets").trash[displayID]; !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return 0, false
+	}
+
+	return displayID, true
+}
+
+// findAsset looks up a live asset by the display ID path parameter, trashed
+// assets are reported as not found.
+func (s *Server) findAsset(w http.ResponseWriter, param string) (Object, bool) {
+	displayID, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	asset, ok := s.store("assets").objects[displayID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return asset, true
+}
+
+// toInt64 converts a decoded JSON number to int64.
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		id, err := v.Int64()
+		return id, err == nil
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	}
+
+	return 0, false
+}
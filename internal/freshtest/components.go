@@ -0,0 +1,43 @@
+package freshtest
+
+import (
+	http "net/http"
+)
+
+func (s *Server) registerComponents() {
+	s.handle("GET", "assets/*/components", s.listAssetComponents)
+}
+
+// AddAssetComponent stores a component of an asset and returns its ID.
+func (s *Server) AddAssetComponent(displayID int64, componentType string, data Object) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	components := s.store("components")
+	return components.insert(Object{
+		"id":               components.nextID,
+		"asset_display_id": displayID,
+		"component_type":   componentType,
+		"component_data":   copyObject(data),
+		"created_at":       now(),
+		"updated_at":       now(),
+	})
+}
+
+func (s *Server) listAssetComponents(w http.ResponseWriter, r *http.Request, params []string) {
+	asset, ok := s.findAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	components := []Object{}
+	for _, component := range s.store("components").sorted() {
+		if component["asset_display_id"] == asset["display_id"] {
+			response := copyObject(component)
+			delete(response, "asset_display_id")
+			components = append(components, response)
+		}
+	}
+
+	writePage(w, r, "components", components)
+}
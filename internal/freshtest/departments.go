@@ -0,0 +1,176 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strings"
+)
+
+func (s *Server) registerDepartments() {
+	s.handle("GET", "departments", s.listDepartments)
+	s.handle("POST", "departments", s.createDepartment)
+	s.handle("GET", "departments/*", s.getDepartment)
+	s.handle("PUT", "departments/*", s.updateDepartment)
+	s.handle("DELETE", "departments/*", s.deleteDepartment)
+}
+
+// AddDepartment stores a department and returns its ID.
+func (s *Server) AddDepartment(name string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	departments := s.store("departments")
+	return departments.insert(Object{
+		"id":            departments.nextID,
+		"name":          name,
+		"description":   "",
+		"head_user_id":  nil,
+		"prime_user_id": nil,
+		"domains":       []interface{}{},
+		"custom_fields": Object{},
+		"created_at":    now(),
+		"updated_at":    now(),
+	})
+}
+
+func (s *Server) listDepartments(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "departments", s.store("departments").sorted())
+}
+
+func (s *Server) createDepartment(w http.ResponseWriter, r *http.Request, params []string) {
+	department, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if errors := s.validateDepartment(0, department); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	departments := s.store("departments")
+	stored := Object{
+		"description":   "",
+		"head_user_id":  nil,
+		"prime_user_id": nil,
+		"domains":       []interface{}{},
+	}
+	mergeDepartment(stored, department)
+	stored["id"] = departments.nextID
+	stored["created_at"] = now()
+	stored["updated_at"] = now()
+	departments.insert(stored)
+
+	writeJSON(w, http.StatusCreated, Object{"department": stored})
+}
+
+func (s *Server) getDepartment(w http.ResponseWriter, r *http.Request, params []string) {
+	department, ok := s.findDepartment(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"department": department})
+}
+
+func (s *Server) updateDepartment(w http.ResponseWriter, r *http.Request, params []string) {
+	department, ok := s.findDepartment(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(department["id"])
+	updated := copyObject(department)
+	merge(updated, update)
+	if errors := s.validateDepartment(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	mergeDepartment(department, update)
+	department["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"department": department})
+}
+
+func (s *Server) deleteDepartment(w http.ResponseWriter, r *http.Request, params []string) {
+	department, ok := s.findDepartment(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(department["id"])
+	delete(s.store("departments").objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mergeDepartment applies an update to a department, custom fields are
+// merged one by one.
+func mergeDepartment(department Object, update Object) {
+	customFields := Object{}
+	if stored, ok := department["custom_fields"].(Object); ok {
+		merge(customFields, stored)
+	}
+	if changed, ok := update["custom_fields"].(Object); ok {
+		merge(customFields, changed)
+	}
+
+	merge(department, update)
+	department["custom_fields"] = customFields
+}
+
+// validateDepartment returns the field errors of a department create or
+// update, id is the department being updated or 0 on create. Names are unique
+// regardless of case.
+func (s *Server) validateDepartment(id int64, department Object) []fieldError {
+	var errors []fieldError
+
+	name, _ := department["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+	for otherID, other := range s.store("departments").objects {
+		if otherName, _ := other["name"].(string); otherID != id && strings.EqualFold(otherName, name) {
+			errors = append(errors, fieldError{Field: "name", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	if domains, ok := department["domains"]; ok && domains != nil {
+		list, ok := domains.([]interface{})
+		for _, domain := range list {
+			if _, isString := domain.(string); !isString {
+				ok = false
+			}
+		}
+		if !ok {
+			errors = append(errors, fieldError{Field: "domains", Message: "It should be an array of strings", Code: "datatype_mismatch"})
+		}
+	}
+
+	if customFields, ok := department["custom_fields"]; ok && customFields != nil {
+		if _, ok := customFields.(Object); !ok {
+			errors = append(errors, fieldError{Field: "custom_fields", Message: "It should be a key/value pair", Code: "datatype_mismatch"})
+		}
+	}
+
+	return errors
+}
+
+// findDepartment looks up a department by the ID path parameter.
+func (s *Server) findDepartment(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	department, ok := s.store("departments").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return department, true
+}
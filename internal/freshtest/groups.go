@@ -0,0 +1,186 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strings"
+)
+
+// unassignedForValues are the accepted escalation delays of agent groups.
+var unassignedForValues = []string{"30m", "1h", "2h", "4h", "8h", "12h", "1d", "2d", "3d"}
+
+func (s *Server) registerGroups() {
+	s.handle("GET", "groups", s.listGroups)
+	s.handle("POST", "groups", s.createGroup)
+	s.handle("GET", "groups/*", s.getGroup)
+	s.handle("PUT", "groups/*", s.updateGroup)
+	s.handle("DELETE", "groups/*", s.deleteGroup)
+}
+
+// AddGroup stores an agent group with the given members and returns its ID.
+func (s *Server) AddGroup(name string, members ...int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	memberIDs := []interface{}{}
+	for _, member := range members {
+		memberIDs = append(memberIDs, member)
+	}
+
+	groups := s.store("groups")
+	return groups.insert(Object{
+		"id":                groups.nextID,
+		"name":              name,
+		"description":       "",
+		"escalate_to":       nil,
+		"unassigned_for":    nil,
+		"business_hours_id": nil,
+		"members":           memberIDs,
+		"observers":         []interface{}{},
+		"leaders":           []interface{}{},
+		"created_at":        now(),
+		"updated_at":        now(),
+	})
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "groups", s.store("groups").sorted())
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	stored := Object{
+		"description":       "",
+		"escalate_to":       nil,
+		"unassigned_for":    nil,
+		"business_hours_id": nil,
+		"members":           []interface{}{},
+		"observers":         []interface{}{},
+		"leaders":           []interface{}{},
+	}
+	merge(stored, group)
+	if errors := s.validateGroup(0, stored); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	groups := s.store("groups")
+	stored["id"] = groups.nextID
+	stored["created_at"] = now()
+	stored["updated_at"] = now()
+	groups.insert(stored)
+
+	writeJSON(w, http.StatusCreated, Object{"group": stored})
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"group": group})
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	updated := copyObject(group)
+	merge(updated, update)
+	if errors := s.validateGroup(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	merge(group, update)
+	group["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"group": group})
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	delete(s.store("groups").objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateGroup returns the field errors of an agent group create or update,
+// id is the group being updated or 0 on create. Names are unique regardless
+// of case and escalating needs an agent to escalate to.
+func (s *Server) validateGroup(id int64, group Object) []fieldError {
+	var errors []fieldError
+
+	name, _ := group["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+	for otherID, other := range s.store("groups").objects {
+		if otherName, _ := other["name"].(string); otherID != id && strings.EqualFold(otherName, name) {
+			errors = append(errors, fieldError{Field: "name", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	for _, field := range []string{"members", "observers", "leaders"} {
+		if !isIDList(group[field]) {
+			errors = append(errors, fieldError{Field: field, Message: "It should be an array of integers", Code: "datatype_mismatch"})
+		}
+	}
+
+	if group["unassigned_for"] != nil {
+		if !oneOf(group["unassigned_for"], unassignedForValues) {
+			errors = append(errors, fieldError{Field: "unassigned_for", Message: "It should be one of these values: '" + strings.Join(unassignedForValues, ",") + "'", Code: "invalid_value"})
+		}
+		if group["escalate_to"] == nil {
+			errors = append(errors, fieldError{Field: "escalate_to", Message: "It should not be blank when unassigned_for is set", Code: "missing_field"})
+		}
+	}
+
+	return errors
+}
+
+// isIDList reports whether value is a list of IDs.
+func isIDList(value interface{}) bool {
+	list, ok := value.([]interface{})
+	if !ok {
+		return false
+	}
+	for _, element := range list {
+		if _, ok := toInt64(element); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findGroup looks up an agent group by the ID path parameter.
+func (s *Server) findGroup(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	group, ok := s.store("groups").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return group, true
+}
@@ -0,0 +1,196 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strings"
+)
+
+func (s *Server) registerLocations() {
+	s.handle("GET", "locations", s.listLocations)
+	s.handle("POST", "locations", s.createLocation)
+	s.handle("GET", "locations/*", s.getLocation)
+	s.handle("PUT", "locations/*", s.updateLocation)
+	s.handle("DELETE", "locations/*", s.deleteLocation)
+}
+
+// AddLocation stores a location below parentID, 0 for a top level location,
+// and returns its ID.
+func (s *Server) AddLocation(name string, parentID int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var parent interface{}
+	if parentID != 0 {
+		parent = parentID
+	}
+
+	locations := s.store("locations")
+	return locations.insert(Object{
+		"id":                 locations.nextID,
+		"name":               name,
+		"parent_location_id": parent,
+		"primary_contact_id": nil,
+		"address":            emptyAddress(),
+		"created_at":         now(),
+		"updated_at":         now(),
+	})
+}
+
+// emptyAddress returns the address of a location without one.
+func emptyAddress() Object {
+	return Object{"line1": "", "line2": "", "city": "", "state": "", "country": "", "zipcode": ""}
+}
+
+func (s *Server) listLocations(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "locations", s.store("locations").sorted())
+}
+
+func (s *Server) createLocation(w http.ResponseWriter, r *http.Request, params []string) {
+	location, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if errors := s.validateLocation(0, location); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	locations := s.store("locations")
+	stored := Object{
+		"parent_location_id": nil,
+		"primary_contact_id": nil,
+		"address":            emptyAddress(),
+	}
+	mergeLocation(stored, location)
+	stored["id"] = locations.nextID
+	stored["created_at"] = now()
+	stored["updated_at"] = now()
+	locations.insert(stored)
+
+	writeJSON(w, http.StatusCreated, Object{"location": stored})
+}
+
+func (s *Server) getLocation(w http.ResponseWriter, r *http.Request, params []string) {
+	location, ok := s.findLocation(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"location": location})
+}
+
+func (s *Server) updateLocation(w http.ResponseWriter, r *http.Request, params []string) {
+	location, ok := s.findLocation(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(location["id"])
+	updated := copyObject(location)
+	merge(updated, update)
+	if errors := s.validateLocation(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	mergeLocation(location, update)
+	location["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"location": location})
+}
+
+// deleteLocation deletes a location, locations with children cannot be
+// deleted.
+func (s *Server) deleteLocation(w http.ResponseWriter, r *http.Request, params []string) {
+	location, ok := s.findLocation(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(location["id"])
+	for _, other := range s.store("locations").objects {
+		if parentID, _ := toInt64(other["parent_location_id"]); parentID == id {
+			writeError(w, http.StatusBadRequest, "Location has child locations")
+			return
+		}
+	}
+
+	delete(s.store("locations").objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mergeLocation applies an update to a location, address fields are merged
+// one by one.
+func mergeLocation(location Object, update Object) {
+	address := emptyAddress()
+	if stored, ok := location["address"].(Object); ok {
+		merge(address, stored)
+	}
+	if changed, ok := update["address"].(Object); ok {
+		merge(address, changed)
+	}
+
+	merge(location, update)
+	location["address"] = address
+}
+
+// validateLocation returns the field errors of a location create or update,
+// id is the location being updated or 0 on create. Names only have to be
+// unique among the children of the same parent and a location cannot be
+// moved below itself.
+func (s *Server) validateLocation(id int64, location Object) []fieldError {
+	var errors []fieldError
+
+	name, _ := location["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+	parentID, _ := toInt64(location["parent_location_id"])
+	for otherID, other := range s.store("locations").objects {
+		otherParentID, _ := toInt64(other["parent_location_id"])
+		if otherID != id && otherParentID == parentID && other["name"] == name {
+			errors = append(errors, fieldError{Field: "name", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	if location["parent_location_id"] != nil {
+		locations := s.store("locations").objects
+		if _, ok := locations[parentID]; !ok {
+			errors = append(errors, fieldError{Field: "parent_location_id", Message: "It should be a valid location", Code: "invalid_value"})
+		}
+		for ancestorID := parentID; ancestorID != 0 && id != 0; {
+			if ancestorID == id {
+				errors = append(errors, fieldError{Field: "parent_location_id", Message: "It should not be the location or one of its children", Code: "invalid_value"})
+				break
+			}
+			ancestorID, _ = toInt64(locations[ancestorID]["parent_location_id"])
+		}
+	}
+
+	if _, ok := location["address"].(Object); !ok && location["address"] != nil {
+		errors = append(errors, fieldError{Field: "address", Message: "It should be a key/value pair", Code: "datatype_mismatch"})
+	}
+
+	return errors
+}
+
+// findLocation looks up a location by the ID path parameter.
+func (s *Server) findLocation(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	location, ok := s.store("locations").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return location, true
+}
@@ -0,0 +1,142 @@
+package freshtest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// typeFieldSuffix matches the asset type ID suffix of type field keys.
+var typeFieldSuffix = regexp.MustCompile(`_\d+$`)
+
+// filterTerm matches a single condition of a filter query, e.g.
+// asset_type_id:5, name:'web-01' or created_at:>'2023-01-01'.
+var filterTerm = regexp.MustCompile(`^\s*(\w+)\s*:\s*([<>]?)\s*('(?:[^']*)'|[\w.-]+)\s*$`)
+
+// predicate reports whether an object matches a query.
+type predicate func(object Object) bool
+
+// parseFilter parses a filter query made of conditions joined with AND and
+// OR, AND binds tighter than OR like in the real API.
+func parseFilter(query string) (predicate, error) {
+	query = strings.Trim(query, `"`)
+
+	var alternatives [][]predicate
+	for _, alternative := range strings.Split(query, " OR ") {
+		var conditions []predicate
+		for _, term := range strings.Split(alternative, " AND ") {
+			condition, err := parseTerm(term)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, condition)
+		}
+		alternatives = append(alternatives, conditions)
+	}
+
+	return func(object Object) bool {
+		for _, conditions := range alternatives {
+			matched := true
+			for _, condition := range conditions {
+				if !condition(object) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// parseTerm parses a single filter condition.
+func parseTerm(term string) (predicate, error) {
+	match := filterTerm.FindStringSubmatch(term)
+	if match == nil {
+		return nil, fmt.Errorf("invalid filter condition %q", term)
+	}
+	field, operator, value := match[1], match[2], strings.Trim(match[3], "'")
+
+	return func(object Object) bool {
+		actual, ok := lookupField(object, field)
+		if value == "null" {
+			return !ok || actual == nil
+		}
+		if !ok || actual == nil {
+			return false
+		}
+
+		got := fmt.Sprint(actual)
+		switch operator {
+		case ">":
+			return compare(got, value) > 0
+		case "<":
+			return compare(got, value) < 0
+		}
+		return got == value
+	}, nil
+}
+
+// parseSearch parses a search query like name:'web' into a predicate doing a
+// case insensitive substring match on name, asset_tag or serial_number.
+func parseSearch(query string) (predicate, error) {
+	query = strings.Trim(query, `"`)
+
+	match := filterTerm.FindStringSubmatch(query)
+	if match == nil || match[2] != "" {
+		return nil, fmt.Errorf("invalid search query %q", query)
+	}
+	field, value := match[1], strings.ToLower(strings.Trim(match[3], "'"))
+
+	switch field {
+	case "name", "asset_tag", "serial_number":
+	default:
+		return nil, fmt.Errorf("search is not supported on %q", field)
+	}
+
+	return func(object Object) bool {
+		actual, ok := lookupField(object, field)
+		if !ok || actual == nil {
+			return false
+		}
+		return strings.Contains(strings.ToLower(fmt.Sprint(actual)), value)
+	}, nil
+}
+
+// lookupField returns a top level field of object or a type field by its
+// name without the asset type ID suffix.
+func lookupField(object Object, field string) (interface{}, bool) {
+	if value, ok := object[field]; ok {
+		return value, true
+	}
+
+	typeFields, _ := object["type_fields"].(Object)
+	for key, value := range typeFields {
+		if typeFieldSuffix.ReplaceAllString(key, "") == field {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// compare compares two values numerically when both are numbers and as
+// strings otherwise.
+func compare(a string, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX == nil && errY == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,364 @@
+package freshtest
+
+import (
+	"fmt"
+	http "net/http"
+	"strconv"
+	"strings"
+)
+
+// job is a background job, it reports "in progress" on the first poll to
+// make clients wait for it.
+type job struct {
+	result  Object
+	pending int
+}
+
+func (s *Server) registerRelationships() {
+	s.handle("GET", "relationship_types", s.listRelationshipTypes)
+	s.handle("POST", "relationship_types", s.createRelationshipType)
+	s.handle("GET", "relationship_types/*", s.getRelationshipType)
+	s.handle("PUT", "relationship_types/*", s.updateRelationshipType)
+	s.handle("DELETE", "relationship_types/*", s.deleteRelationshipType)
+	s.handle("GET", "relationships", s.listRelationships)
+	s.handle("POST", "relationships/bulk-create", s.bulkCreateRelationships)
+	s.handle("DELETE", "relationships", s.deleteRelationships)
+	s.handle("GET", "relationships/*", s.getRelationship)
+	s.handle("GET", "assets/*/relationships", s.listAssetRelationships)
+	s.handle("GET", "jobs/*", s.getJob)
+}
+
+// AddRelationshipType stores a relationship type and returns its ID.
+func (s *Server) AddRelationshipType(downstreamRelation string, upstreamRelation string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	relationshipTypes := s.store("relationship_types")
+	return relationshipTypes.insert(Object{
+		"id":                  relationshipTypes.nextID,
+		"description":         downstreamRelation + " / " + upstreamRelation,
+		"downstream_relation": downstreamRelation,
+		"upstream_relation":   upstreamRelation,
+		"created_at":          now(),
+		"updated_at":          now(),
+	})
+}
+
+// Relationship returns a copy of the relationship with the given ID or nil.
+func (s *Server) Relationship(id int64) Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	relationship, ok := s.store("relationships").objects[id]
+	if !ok {
+		return nil
+	}
+
+	return copyObject(relationship)
+}
+
+// removeAssetRelationships deletes the relationships of a deleted asset.
+func (s *Server) removeAssetRelationships(displayID int64) {
+	relationships := s.store("relationships")
+	for id, relationship := range relationships.objects {
+		if involvesAsset(relationship, displayID) {
+			delete(relationships.objects, id)
+		}
+	}
+}
+
+// involvesAsset reports whether the asset is either end of the relationship.
+func involvesAsset(relationship Object, displayID int64) bool {
+	primaryID, _ := toInt64(relationship["primary_id"])
+	secondaryID, _ := toInt64(relationship["secondary_id"])
+
+	return (relationship["primary_type"] == "asset" && primaryID == displayID) ||
+		(relationship["secondary_type"] == "asset" && secondaryID == displayID)
+}
+
+func (s *Server) listRelationshipTypes(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "relationship_types", s.store("relationship_types").sorted())
+}
+
+func (s *Server) createRelationshipType(w http.ResponseWriter, r *http.Request, params []string) {
+	relationshipType, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if errors := s.validateRelationshipType(0, relationshipType); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	relationshipTypes := s.store("relationship_types")
+	relationshipType["id"] = relationshipTypes.nextID
+	relationshipType["created_at"] = now()
+	relationshipType["updated_at"] = now()
+	relationshipTypes.insert(relationshipType)
+
+	writeJSON(w, http.StatusCreated, Object{"relationship_type": relationshipType})
+}
+
+func (s *Server) getRelationshipType(w http.ResponseWriter, r *http.Request, params []string) {
+	relationshipType, ok := s.findRelationshipType(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"relationship_type": relationshipType})
+}
+
+func (s *Server) updateRelationshipType(w http.ResponseWriter, r *http.Request, params []string) {
+	relationshipType, ok := s.findRelationshipType(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(relationshipType["id"])
+	updated := copyObject(relationshipType)
+	merge(updated, update)
+	if errors := s.validateRelationshipType(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	merge(relationshipType, update)
+	relationshipType["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"relationship_type": relationshipType})
+}
+
+// deleteRelationshipType deletes a relationship type, types still used by a
+// relationship cannot be deleted.
+func (s *Server) deleteRelationshipType(w http.ResponseWriter, r *http.Request, params []string) {
+	relationshipType, ok := s.findRelationshipType(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(relationshipType["id"])
+	for _, relationship := range s.store("relationships").objects {
+		if relationshipTypeID, _ := toInt64(relationship["relationship_type_id"]); relationshipTypeID == id {
+			writeError(w, http.StatusBadRequest, "Relationship type is in use by relationships")
+			return
+		}
+	}
+
+	delete(s.store("relationship_types").objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateRelationshipType returns the field errors of a relationship type
+// create or update, id is the type being updated or 0 on create.
+func (s *Server) validateRelationshipType(id int64, relationshipType Object) []fieldError {
+	var errors []fieldError
+
+	for _, field := range []string{"downstream_relation", "upstream_relation"} {
+		if value, _ := relationshipType[field].(string); value == "" {
+			errors = append(errors, fieldError{Field: field, Message: "It should not be blank", Code: "missing_field"})
+		}
+	}
+
+	for otherID, other := range s.store("relationship_types").objects {
+		if otherID != id && other["downstream_relation"] == relationshipType["downstream_relation"] && other["upstream_relation"] == relationshipType["upstream_relation"] {
+			errors = append(errors, fieldError{Field: "downstream_relation", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	return errors
+}
+
+// findRelationshipType looks up a relationship type by the ID path parameter.
+func (s *Server) findRelationshipType(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	relationshipType, ok := s.store("relationship_types").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return relationshipType, true
+}
+
+func (s *Server) listRelationships(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "relationships", s.store("relationships").sorted())
+}
+
+func (s *Server) listAssetRelationships(w http.ResponseWriter, r *http.Request, params []string) {
+	asset, ok := s.findAsset(w, params[0])
+	if !ok {
+		return
+	}
+
+	displayID, _ := toInt64(asset["display_id"])
+	relationships := []Object{}
+	for _, relationship := range s.store("relationships").sorted() {
+		if involvesAsset(relationship, displayID) {
+			relationships = append(relationships, relationship)
+		}
+	}
+
+	writePage(w, r, "relationships", relationships)
+}
+
+func (s *Server) getRelationship(w http.ResponseWriter, r *http.Request, params []string) {
+	id, ok := parseID(w, params[0])
+	if !ok {
+		return
+	}
+
+	relationship, ok := s.store("relationships").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"relationship": relationship})
+}
+
+// bulkCreateRelationships queues a job creating every relationship that is
+// valid, the outcome of each one is reported by the job.
+func (s *Server) bulkCreateRelationships(w http.ResponseWriter, r *http.Request, params []string) {
+	body, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	items, _ := body["relationships"].([]interface{})
+	if len(items) == 0 {
+		writeValidationError(w, []fieldError{{Field: "relationships", Message: "It should not be blank", Code: "missing_field"}})
+		return
+	}
+
+	results := []Object{}
+	succeeded := 0
+	for _, item := range items {
+		relationship, _ := item.(Object)
+		result := copyObject(relationship)
+		if errors := s.validateRelationship(relationship); len(errors) > 0 {
+			result["success"] = false
+			result["errors"] = errors
+		} else {
+			relationships := s.store("relationships")
+			relationship := copyObject(relationship)
+			relationship["id"] = relationships.nextID
+			relationship["created_at"] = now()
+			relationship["updated_at"] = now()
+			relationships.insert(relationship)
+
+			result = copyObject(relationship)
+			result["success"] = true
+			succeeded++
+		}
+		results = append(results, result)
+	}
+
+	status := "partial"
+	switch succeeded {
+	case len(items):
+		status = "success"
+	case 0:
+		status = "failed"
+	}
+
+	jobID := fmt.Sprintf("job-%d", len(s.jobs)+1)
+	href := s.Endpoint() + "/jobs/" + jobID
+	s.jobs[jobID] = &job{
+		result:  Object{"job_id": jobID, "status": status, "href": href, "relationships": results},
+		pending: 1,
+	}
+
+	writeJSON(w, http.StatusAccepted, Object{"job_id": jobID, "href": href})
+}
+
+// validateRelationship returns the errors of a relationship to create, the
+// fake only relates assets.
+func (s *Server) validateRelationship(relationship Object) []string {
+	var errors []string
+
+	relationshipTypeID, _ := toInt64(relationship["relationship_type_id"])
+	if _, ok := s.store("relationship_types").objects[relationshipTypeID]; !ok {
+		errors = append(errors, "relationship_type_id should be a valid relationship type")
+	}
+
+	for _, end := range []string{"primary", "secondary"} {
+		if relationship[end+"_type"] != "asset" {
+			errors = append(errors, end+"_type should be asset")
+			continue
+		}
+		displayID, _ := toInt64(relationship[end+"_id"])
+		if _, ok := s.store("assets").objects[displayID]; !ok {
+			errors = append(errors, end+"_id should be a valid asset")
+		}
+	}
+	if len(errors) > 0 {
+		return errors
+	}
+
+	primaryID, _ := toInt64(relationship["primary_id"])
+	secondaryID, _ := toInt64(relationship["secondary_id"])
+	if primaryID == secondaryID {
+		return []string{"an asset cannot be related to itself"}
+	}
+	for _, other := range s.store("relationships").objects {
+		otherTypeID, _ := toInt64(other["relationship_type_id"])
+		otherPrimaryID, _ := toInt64(other["primary_id"])
+		otherSecondaryID, _ := toInt64(other["secondary_id"])
+		if otherTypeID == relationshipTypeID && otherPrimaryID == primaryID && otherSecondaryID == secondaryID {
+			return []string{"relationship already exists"}
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request, params []string) {
+	job, ok := s.jobs[params[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	if job.pending > 0 {
+		job.pending--
+		writeJSON(w, http.StatusOK, Object{"job_id": job.result["job_id"], "status": "in progress", "href": job.result["href"]})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job.result)
+}
+
+// deleteRelationships deletes the relationships listed in the ids query
+// parameter.
+func (s *Server) deleteRelationships(w http.ResponseWriter, r *http.Request, params []string) {
+	relationships := s.store("relationships")
+
+	deleted := 0
+	for _, value := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "ids should be a comma separated list of IDs")
+			return
+		}
+		if _, ok := relationships.objects[id]; ok {
+			delete(relationships.objects, id)
+			deleted++
+		}
+	}
+
+	if deleted == 0 {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,291 @@
+package freshtest
+
+import (
+	http "net/http"
+	"strings"
+)
+
+// requesterGroupTypes are the accepted types of requester groups.
+var requesterGroupTypes = []string{"manual", "rule_based"}
+
+func (s *Server) registerRequesterGroups() {
+	s.handle("GET", "requester_groups", s.listRequesterGroups)
+	s.handle("POST", "requester_groups", s.createRequesterGroup)
+	s.handle("GET", "requester_groups/*", s.getRequesterGroup)
+	s.handle("PUT", "requester_groups/*", s.updateRequesterGroup)
+	s.handle("DELETE", "requester_groups/*", s.deleteRequesterGroup)
+	s.handle("GET", "requester_groups/*/members", s.listRequesterGroupMembers)
+	s.handle("POST", "requester_groups/*/members/*", s.addRequesterGroupMember)
+	s.handle("DELETE", "requester_groups/*/members/*", s.removeRequesterGroupMember)
+}
+
+// AddRequester stores a requester and returns its ID.
+func (s *Server) AddRequester(firstName string, email string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requesters := s.store("requesters")
+	return requesters.insert(Object{
+		"id":             requesters.nextID,
+		"first_name":     firstName,
+		"last_name":      nil,
+		"primary_email":  email,
+		"active":         true,
+		"department_ids": []interface{}{},
+		"location_id":    nil,
+		"created_at":     now(),
+		"updated_at":     now(),
+	})
+}
+
+// AddRequesterGroup stores a requester group of the given type with the
+// given members and returns its ID, members of rule based groups stand in
+// for the requesters matching its rules.
+func (s *Server) AddRequesterGroup(name string, groupType string, members ...int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := s.store("requester_groups")
+	id := groups.insert(Object{
+		"id":          groups.nextID,
+		"name":        name,
+		"description": "",
+		"type":        groupType,
+		"created_at":  now(),
+		"updated_at":  now(),
+	})
+	for _, member := range members {
+		s.insertRequesterGroupMember(id, member)
+	}
+
+	return id
+}
+
+func (s *Server) insertRequesterGroupMember(groupID int64, requesterID int64) {
+	members := s.store("requester_group_members")
+	members.insert(Object{
+		"id":                 members.nextID,
+		"requester_group_id": groupID,
+		"requester_id":       requesterID,
+	})
+}
+
+// requesterGroupMember returns the ID of the membership of a requester in a
+// group and whether there is one.
+func (s *Server) requesterGroupMember(groupID int64, requesterID int64) (int64, bool) {
+	for id, member := range s.store("requester_group_members").objects {
+		memberGroupID, _ := toInt64(member["requester_group_id"])
+		memberRequesterID, _ := toInt64(member["requester_id"])
+		if memberGroupID == groupID && memberRequesterID == requesterID {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *Server) listRequesterGroups(w http.ResponseWriter, r *http.Request, params []string) {
+	writePage(w, r, "requester_groups", s.store("requester_groups").sorted())
+}
+
+func (s *Server) createRequesterGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	stored := Object{
+		"description": "",
+		"type":        "manual",
+	}
+	merge(stored, group)
+	if errors := s.validateRequesterGroup(0, stored); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	groups := s.store("requester_groups")
+	stored["id"] = groups.nextID
+	stored["created_at"] = now()
+	stored["updated_at"] = now()
+	groups.insert(stored)
+
+	writeJSON(w, http.StatusCreated, Object{"requester_group": stored})
+}
+
+func (s *Server) getRequesterGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findRequesterGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, Object{"requester_group": group})
+}
+
+// updateRequesterGroup updates a requester group, the type of a group cannot
+// be changed.
+func (s *Server) updateRequesterGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findRequesterGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	update, ok := decodeBody(w, r)
+	if !ok {
+		return
+	}
+
+	if groupType, ok := update["type"]; ok && groupType != group["type"] {
+		writeValidationError(w, []fieldError{{Field: "type", Message: "It cannot be changed", Code: "invalid_field"}})
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	updated := copyObject(group)
+	merge(updated, update)
+	if errors := s.validateRequesterGroup(id, updated); len(errors) > 0 {
+		writeValidationError(w, errors)
+		return
+	}
+
+	merge(group, update)
+	group["updated_at"] = now()
+	writeJSON(w, http.StatusOK, Object{"requester_group": group})
+}
+
+// deleteRequesterGroup deletes a requester group together with its members.
+func (s *Server) deleteRequesterGroup(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findRequesterGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	delete(s.store("requester_groups").objects, id)
+	members := s.store("requester_group_members")
+	for memberID, member := range members.objects {
+		if groupID, _ := toInt64(member["requester_group_id"]); groupID == id {
+			delete(members.objects, memberID)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateRequesterGroup returns the field errors of a requester group create
+// or update, id is the group being updated or 0 on create. Names are unique
+// regardless of case.
+func (s *Server) validateRequesterGroup(id int64, group Object) []fieldError {
+	var errors []fieldError
+
+	name, _ := group["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		errors = append(errors, fieldError{Field: "name", Message: "It should not be blank", Code: "missing_field"})
+	}
+	for otherID, other := range s.store("requester_groups").objects {
+		if otherName, _ := other["name"].(string); otherID != id && strings.EqualFold(otherName, name) {
+			errors = append(errors, fieldError{Field: "name", Message: "It should be a unique value", Code: "duplicate_value"})
+		}
+	}
+
+	if !oneOf(group["type"], requesterGroupTypes) {
+		errors = append(errors, fieldError{Field: "type", Message: "It should be one of these values: '" + strings.Join(requesterGroupTypes, ",") + "'", Code: "invalid_value"})
+	}
+
+	return errors
+}
+
+// listRequesterGroupMembers lists the requesters in a group in ID order.
+func (s *Server) listRequesterGroupMembers(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findRequesterGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	requesters := s.store("requesters")
+	members := []Object{}
+	for _, requester := range requesters.sorted() {
+		requesterID, _ := toInt64(requester["id"])
+		if _, ok := s.requesterGroupMember(id, requesterID); ok {
+			members = append(members, requester)
+		}
+	}
+
+	writePage(w, r, "requesters", members)
+}
+
+// addRequesterGroupMember adds a requester to a manual group, members of rule
+// based groups follow from the rules.
+func (s *Server) addRequesterGroupMember(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findRequesterGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	requesterID, ok := parseID(w, params[1])
+	if !ok {
+		return
+	}
+	if _, ok := s.store("requesters").objects[requesterID]; !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	if group["type"] != "manual" {
+		writeError(w, http.StatusBadRequest, "Requesters can only be added to manual requester groups")
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	if _, ok := s.requesterGroupMember(id, requesterID); ok {
+		writeValidationError(w, []fieldError{{Field: "requester_id", Message: "It should be a unique value", Code: "duplicate_value"}})
+		return
+	}
+
+	s.insertRequesterGroupMember(id, requesterID)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// removeRequesterGroupMember removes a requester from a manual group.
+func (s *Server) removeRequesterGroupMember(w http.ResponseWriter, r *http.Request, params []string) {
+	group, ok := s.findRequesterGroup(w, params[0])
+	if !ok {
+		return
+	}
+
+	requesterID, ok := parseID(w, params[1])
+	if !ok {
+		return
+	}
+
+	if group["type"] != "manual" {
+		writeError(w, http.StatusBadRequest, "Requesters can only be removed from manual requester groups")
+		return
+	}
+
+	id, _ := toInt64(group["id"])
+	memberID, ok := s.requesterGroupMember(id, requesterID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	delete(s.store("requester_group_members").objects, memberID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findRequesterGroup looks up a requester group by the ID path parameter.
+func (s *Server) findRequesterGroup(w http.ResponseWriter, param string) (Object, bool) {
+	id, ok := parseID(w, param)
+	if !ok {
+		return nil, false
+	}
+
+	group, ok := s.store("requester_groups").objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return nil, false
+	}
+
+	return group, true
+}
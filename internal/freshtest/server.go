@@ -0,0 +1,358 @@
+// Package freshtest provides an in-memory fake of the FreshService v2 API for
+// unit and acceptance tests.
+package freshtest
+
+import (
+	"encoding/json"
+	"fmt"
+	http "net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// APIKey is the only API key accepted by the fake server.
+const APIKey = "freshtest-api-key"
+
+// Object is a single JSON object stored by the fake server.
+type Object = map[string]interface{}
+
+// Server is a fake FreshService API backed by in-memory collections.
+type Server struct {
+	*httptest.Server
+
+	mu              sync.Mutex
+	collections     map[string]*collection
+	assetTypeFields map[int64][]Object
+	jobs            map[string]*job
+	routes          []route
+	failures        []failure
+	requests        int
+}
+
+// failure is an injected error response.
+type failure struct {
+	status     int
+	retryAfter string
+}
+
+// route maps a method and path to a handler, "*" in a pattern matches any
+// single path segment which is passed to the handler as a parameter.
+type route struct {
+	method  string
+	pattern []string
+	handler func(w http.ResponseWriter, r *http.Request, params []string)
+}
+
+// NewServer starts a fake FreshService API which is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		collections:     map[string]*collection{},
+		assetTypeFields: map[int64][]Object{},
+		jobs:            map[string]*job{},
+	}
+	s.registerAssets()
+	s.registerAssetTypes()
+	s.registerRelationships()
+	s.registerComponents()
+	s.registerApplications()
+	s.registerDepartments()
+	s.registerLocations()
+	s.registerGroups()
+	s.registerRequesterGroups()
+	s.registerAgents()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Endpoint returns the API endpoint to configure the client with.
+func (s *Server) Endpoint() string {
+	return s.URL + "/api/v2"
+}
+
+// Requests returns the number of requests received so far.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// FailNext makes the next count requests fail with the given status code.
+func (s *Server) FailNext(status int, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		s.failures = append(s.failures, failure{status: status})
+	}
+}
+
+// RateLimitNext makes the next count requests fail with 429 and the given
+// Retry-After header.
+func (s *Server) RateLimitNext(count int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		s.failures = append(s.failures, failure{
+			status:     http.StatusTooManyRequests,
+			retryAfter: strconv.Itoa(int(retryAfter.Seconds())),
+		})
+	}
+}
+
+// handle registers a handler for method and a path relative to /api/v2.
+func (s *Server) handle(method string, pattern string, handler func(w http.ResponseWriter, r *http.Request, params []string)) {
+	s.routes = append(s.routes, route{
+		method:  method,
+		pattern: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler: handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	var injected *failure
+	if len(s.failures) > 0 {
+		injected = &s.failures[0]
+		s.failures = s.failures[1:]
+	}
+	s.mu.Unlock()
+
+	if injected != nil {
+		if injected.retryAfter != "" {
+			w.Header().Set("Retry-After", injected.retryAfter)
+		}
+		writeError(w, injected.status, http.StatusText(injected.status))
+		return
+	}
+
+	if user, _, ok := r.BasicAuth(); !ok || user != APIKey {
+		writeError(w, http.StatusUnauthorized, "You have to be logged in to perform this action.")
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, "/api/v2/") {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/"), "/")
+
+	methodAllowed := true
+	for _, route := range s.routes {
+		params, ok := match(route.pattern, segments)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			methodAllowed = false
+			continue
+		}
+
+		s.mu.Lock()
+		route.handler(w, r, params)
+		s.mu.Unlock()
+		return
+	}
+
+	if !methodAllowed {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "Resource not found")
+}
+
+// match matches path segments against a route pattern.
+func match(pattern []string, segments []string) ([]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	var params []string
+	for i, part := range pattern {
+		switch {
+		case part == "*":
+			params = append(params, segments[i])
+		case part != segments[i]:
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// collection is an in-memory store of JSON objects keyed by ID.
+type collection struct {
+	objects map[int64]Object
+	trash   map[int64]Object
+	nextID  int64
+}
+
+// store returns the named collection, creating it when needed.
+func (s *Server) store(name string) *collection {
+	c, ok := s.collections[name]
+	if !ok {
+		c = &collection{
+			objects: map[int64]Object{},
+			trash:   map[int64]Object{},
+			nextID:  1,
+		}
+		s.collections[name] = c
+	}
+
+	return c
+}
+
+// insert stores object under the next free ID and returns that ID.
+func (c *collection) insert(object Object) int64 {
+	id := c.nextID
+	c.nextID++
+	c.objects[id] = object
+
+	return id
+}
+
+// sorted returns every object in ID order.
+func (c *collection) sorted() []Object {
+	return sortedObjects(c.objects)
+}
+
+// sortedTrash returns every trashed object in ID order.
+func (c *collection) sortedTrash() []Object {
+	return sortedObjects(c.trash)
+}
+
+func sortedObjects(objects map[int64]Object) []Object {
+	ids := make([]int64, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	sorted := make([]Object, 0, len(ids))
+	for _, id := range ids {
+		sorted = append(sorted, objects[id])
+	}
+
+	return sorted
+}
+
+// parseID parses an ID path parameter.
+func parseID(w http.ResponseWriter, param string) (int64, bool) {
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return 0, false
+	}
+
+	return id, true
+}
+
+// decodeBody decodes a JSON request body, numbers are kept as json.Number.
+func decodeBody(w http.ResponseWriter, r *http.Request) (Object, bool) {
+	body := Object{}
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid JSON body")
+		return nil, false
+	}
+
+	return body, true
+}
+
+// copyObject returns a shallow copy of object.
+func copyObject(object Object) Object {
+	copied := make(Object, len(object))
+	for key, value := range object {
+		copied[key] = value
+	}
+
+	return copied
+}
+
+// merge copies every field of update into object.
+func merge(object Object, update Object) {
+	for key, value := range update {
+		object[key] = value
+	}
+}
+
+// now returns the current time in the format used by the API.
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// writeJSON writes body as JSON with the given status code.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error response in the format used by the API.
+func writeError(w http.ResponseWriter, status int, description string) {
+	writeJSON(w, status, Object{
+		"description": description,
+		"errors":      []Object{},
+	})
+}
+
+// fieldError describes a validation error on a single field.
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// writeValidationError writes a 400 response listing field errors.
+func writeValidationError(w http.ResponseWriter, errors []fieldError) {
+	writeJSON(w, http.StatusBadRequest, Object{
+		"description": "Validation failed",
+		"errors":      errors,
+	})
+}
+
+// writePage writes one page of objects under key, honouring the page and
+// per_page query parameters and linking to the next page.
+func writePage(w http.ResponseWriter, r *http.Request, key string, objects []Object) {
+	query := r.URL.Query()
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage <= 0 {
+		perPage = 30
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	start := (page - 1) * perPage
+	if start > len(objects) {
+		start = len(objects)
+	}
+	end := start + perPage
+	if end > len(objects) {
+		end = len(objects)
+	}
+
+	if end < len(objects) {
+		query.Set("page", strconv.Itoa(page+1))
+		query.Set("per_page", strconv.Itoa(perPage))
+		next := fmt.Sprintf("http://%s%s?%s", r.Host, r.URL.Path, query.Encode())
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+	}
+
+	writeJSON(w, http.StatusOK, Object{key: objects[start:end]})
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AgentDataSource{}
+
+func NewAgentDataSource() datasource.DataSource {
+	return &AgentDataSource{}
+}
+
+type AgentDataSource struct {
+	client *freshclient.Client
+}
+
+type AgentDataSourceModel struct {
+	Active             types.Bool       `tfsdk:"active"`
+	CreatedAt          types.String     `tfsdk:"created_at"`
+	DepartmentIDs      []types.Int64    `tfsdk:"department_ids"`
+	Email              types.String     `tfsdk:"email"`
+	FirstName          types.String     `tfsdk:"first_name"`
+	ID                 types.Int64      `tfsdk:"id"`
+	JobTitle           types.String     `tfsdk:"job_title"`
+	LastName           types.String     `tfsdk:"last_name"`
+	LocationID         types.Int64      `tfsdk:"location_id"`
+	MemberOf           []types.Int64    `tfsdk:"member_of"`
+	MobilePhoneNumber  types.String     `tfsdk:"mobile_phone_number"`
+	ObserverOf         []types.Int64    `tfsdk:"observer_of"`
+	Occasional         types.Bool       `tfsdk:"occasional"`
+	ReportingManagerID types.Int64      `tfsdk:"reporting_manager_id"`
+	Roles              []AgentRoleModel `tfsdk:"roles"`
+	UpdatedAt          types.String     `tfsdk:"updated_at"`
+	WorkPhoneNumber    types.String     `tfsdk:"work_phone_number"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AgentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent"
+}
+
+func (m AgentDataSourceModel) fromFreshAgent(agent freshclient.AgentDetails) AgentDataSourceModel {
+	roles := make([]AgentRoleModel, 0, len(agent.Roles))
+	for _, role := range agent.Roles {
+		roles = append(roles, AgentRoleModel{}.fromFreshAgentRole(role))
+	}
+
+	return AgentDataSourceModel{
+		Active:             types.BoolValue(agent.Active),
+		CreatedAt:          types.StringValue(agent.CreatedAt),
+		DepartmentIDs:      int64Values(agent.DepartmentIDs),
+		Email:              types.StringValue(agent.Email),
+		FirstName:          types.StringValue(agent.FirstName),
+		ID:                 types.Int64Value(agent.ID),
+		JobTitle:           types.StringValue(agent.JobTitle),
+		LastName:           types.StringValue(agent.LastName),
+		LocationID:         optionalInt64(agent.LocationID),
+		MemberOf:           int64Values(agent.MemberOf),
+		MobilePhoneNumber:  types.StringValue(agent.MobilePhoneNumber),
+		ObserverOf:         int64Values(agent.ObserverOf),
+		Occasional:         types.BoolValue(agent.Occasional),
+		ReportingManagerID: optionalInt64(agent.ReportingManagerID),
+		Roles:              roles,
+		UpdatedAt:          types.StringValue(agent.UpdatedAt),
+		WorkPhoneNumber:    types.StringValue(agent.WorkPhoneNumber),
+	}
+}
+
+func (d *AgentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Agent Data Source, looks up an agent by email address",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the agent is active, `false` for deactivated agents",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+			},
+			"department_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the departments of the agent",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the agent, compared case-insensitively",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the agent",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the agent",
+				Computed:            true,
+			},
+			"job_title": schema.StringAttribute{
+				MarkdownDescription: "Job title of the agent",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the agent",
+				Computed:            true,
+			},
+			"location_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the location of the agent",
+				Computed:            true,
+			},
+			"member_of": schema.ListAttribute{
+				MarkdownDescription: "IDs of the agent groups the agent is a member of",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"mobile_phone_number": schema.StringAttribute{
+				MarkdownDescription: "Mobile phone number of the agent",
+				Computed:            true,
+			},
+			"observer_of": schema.ListAttribute{
+				MarkdownDescription: "IDs of the agent groups the agent observes",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"occasional": schema.BoolAttribute{
+				MarkdownDescription: "Whether the agent is an occasional agent",
+				Computed:            true,
+			},
+			"reporting_manager_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user the agent reports to",
+				Computed:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Roles of the agent",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"assignment_scope": schema.StringAttribute{
+							MarkdownDescription: "Tickets and assets the role applies to, `entire_helpdesk`, `member_groups`, `specified_groups` or `assigned_items`",
+							Computed:            true,
+						},
+						"groups": schema.ListAttribute{
+							MarkdownDescription: "IDs of the agent groups the role applies to for `specified_groups`",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"role_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the role",
+							Computed:            true,
+						},
+						"workspace_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the workspace the role is assigned in, null for accounts without workspaces",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+			"work_phone_number": schema.StringAttribute{
+				MarkdownDescription: "Work phone number of the agent",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AgentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AgentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AgentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.Email
+	agents, err := d.client.ListAgents(ctx, freshclient.ListAgentsOptions{Email: email.ValueString()})
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting agent", err)
+		return
+	}
+
+	// Email addresses are unique regardless of case, the configured address
+	// is kept as it was written.
+	for _, agent := range agents {
+		if strings.EqualFold(agent.Email, email.ValueString()) {
+			// Save data into Terraform state
+			data = data.fromFreshAgent(agent)
+			data.Email = email
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Error getting agent", fmt.Sprintf("agent %s not found", email.ValueString()))
+}
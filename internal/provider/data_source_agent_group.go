@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AgentGroupDataSource{}
+
+func NewAgentGroupDataSource() datasource.DataSource {
+	return &AgentGroupDataSource{}
+}
+
+type AgentGroupDataSource struct {
+	client *freshclient.Client
+}
+
+type AgentGroupDataSourceModel struct {
+	BusinessHoursID types.Int64   `tfsdk:"business_hours_id"`
+	CreatedAt       types.String  `tfsdk:"created_at"`
+	Description     types.String  `tfsdk:"description"`
+	EscalateTo      types.Int64   `tfsdk:"escalate_to"`
+	ID              types.Int64   `tfsdk:"id"`
+	Leaders         []types.Int64 `tfsdk:"leaders"`
+	Members         []types.Int64 `tfsdk:"members"`
+	Name            types.String  `tfsdk:"name"`
+	Observers       []types.Int64 `tfsdk:"observers"`
+	UnassignedFor   types.String  `tfsdk:"unassigned_for"`
+	UpdatedAt       types.String  `tfsdk:"updated_at"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AgentGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_group"
+}
+
+func (m AgentGroupDataSourceModel) fromFreshAgentGroup(agentGroup freshclient.AgentGroupDetails) AgentGroupDataSourceModel {
+	return AgentGroupDataSourceModel{
+		BusinessHoursID: optionalInt64(agentGroup.BusinessHoursID),
+		CreatedAt:       types.StringValue(agentGroup.CreatedAt),
+		Description:     types.StringValue(agentGroup.Description),
+		EscalateTo:      optionalInt64(agentGroup.EscalateTo),
+		ID:              types.Int64Value(agentGroup.ID),
+		Leaders:         int64Values(agentGroup.Leaders),
+		Members:         int64Values(agentGroup.Members),
+		Name:            types.StringValue(agentGroup.Name),
+		Observers:       int64Values(agentGroup.Observers),
+		UnassignedFor:   optionalString(agentGroup.UnassignedFor),
+		UpdatedAt:       types.StringValue(agentGroup.UpdatedAt),
+	}
+}
+
+func (d *AgentGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Agent Group Data Source, looks up an agent group by name",
+
+		Attributes: map[string]schema.Attribute{
+			"business_hours_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the business hours of the group, null for the default business hours",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group",
+				Computed:            true,
+			},
+			"escalate_to": schema.Int64Attribute{
+				MarkdownDescription: "ID of the agent unassigned tickets are escalated to",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the group",
+				Computed:            true,
+			},
+			"leaders": schema.ListAttribute{
+				MarkdownDescription: "IDs of the agents leading the group",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"members": schema.ListAttribute{
+				MarkdownDescription: "IDs of the agents in the group",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group, compared case-insensitively",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"observers": schema.ListAttribute{
+				MarkdownDescription: "IDs of the agents observing the group",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"unassigned_for": schema.StringAttribute{
+				MarkdownDescription: "Time after which unassigned tickets are escalated",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AgentGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AgentGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AgentGroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentGroups, err := d.client.ListAgentGroups(ctx)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting agent group", err)
+		return
+	}
+
+	// Group names are unique regardless of case, the configured name is kept
+	// as it was written.
+	name := data.Name
+	for _, agentGroup := range agentGroups {
+		if strings.EqualFold(agentGroup.Name, name.ValueString()) {
+			// Save data into Terraform state
+			data = data.fromFreshAgentGroup(agentGroup)
+			data.Name = name
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Error getting agent group", fmt.Sprintf("agent group %s not found", name.ValueString()))
+}
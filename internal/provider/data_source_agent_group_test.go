@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAgentGroupDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name        = "TestAcc Hardware"
+  description = "Repairs and replacements"
+}
+
+data "fresh_agent_group" "test" {
+  name = lower(fresh_agent_group.test.name)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fresh_agent_group.test", "id", "fresh_agent_group.test", "id"),
+					resource.TestCheckResourceAttr("data.fresh_agent_group.test", "name", "testacc hardware"),
+					resource.TestCheckResourceAttr("data.fresh_agent_group.test", "description", "Repairs and replacements"),
+					resource.TestCheckResourceAttr("data.fresh_agent_group.test", "members.#", "0"),
+				),
+			},
+			// Unknown name testing
+			{
+				Config: `
+data "fresh_agent_group" "test" {
+  name = "TestAcc Missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`agent group TestAcc Missing not found`),
+			},
+		},
+	})
+}
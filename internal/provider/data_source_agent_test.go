@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAgentDataSource(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	id := server.AddAgent("Alex", "testacc.lookup@example.com")
+	server.AddAgent("Sam", "testacc.other@example.com")
+	groupID := server.AddGroup("TestAcc Lookup Desk", id)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "fresh_agent" "test" {
+  email = "TestAcc.Lookup@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_agent.test", "id", fmt.Sprint(id)),
+					resource.TestCheckResourceAttr("data.fresh_agent.test", "email", "TestAcc.Lookup@example.com"),
+					resource.TestCheckResourceAttr("data.fresh_agent.test", "first_name", "Alex"),
+					resource.TestCheckResourceAttr("data.fresh_agent.test", "active", "true"),
+					resource.TestCheckResourceAttr("data.fresh_agent.test", "member_of.0", fmt.Sprint(groupID)),
+					resource.TestCheckNoResourceAttr("data.fresh_agent.test", "location_id"),
+				),
+			},
+			// Unknown email testing
+			{
+				Config: `
+data "fresh_agent" "test" {
+  email = "testacc.missing@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`agent testacc.missing@example.com not found`),
+			},
+		},
+	})
+}
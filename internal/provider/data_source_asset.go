@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"terraform-provider-fresh/internal/freshclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &AssetDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &AssetDataSource{}
 
 func NewAssetDataSource() datasource.DataSource {
 	return &AssetDataSource{}
@@ -37,6 +42,8 @@ type AssetDataSourceModel struct {
 	UpdatedAt    types.String `tfsdk:"updated_at"`
 	UsageType    types.String `tfsdk:"usage_type"`
 	UserID       types.Int64  `tfsdk:"user_id"`
+	TypeFields   types.Map    `tfsdk:"type_fields"`
+	SerialNumber types.String `tfsdk:"serial_number"`
 }
 
 // Metadata returns the metadata for the data source.
@@ -63,22 +70,40 @@ func (m AssetDataSourceModel) fromFreshAsset(assetDetails freshclient.AssetDetai
 		UpdatedAt:    types.StringValue(assetDetails.UpdatedAt),
 		UsageType:    types.StringValue(assetDetails.UsageType),
 		UserID:       types.Int64Value(assetDetails.UserID),
+		TypeFields:   typeFieldsValue(assetDetails.TypeFields),
+		SerialNumber: serialNumberValue(assetDetails.TypeFields),
 	}
 }
 
+// serialNumberValue returns the serial_number type field, null when the
+// asset type has no such field or type fields were not requested.
+func serialNumberValue(typeFields map[string]interface{}) types.String {
+	value, ok := freshclient.TrimTypeFields(typeFields)[assetSerialNumberField]
+	if !ok {
+		return types.StringNull()
+	}
+	if value == nil {
+		return types.StringValue("")
+	}
+
+	return types.StringValue(typeFieldString(value))
+}
+
 func (d *AssetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Asset Type Data Source",
+		MarkdownDescription: "Asset Data Source, looks up a single asset by exactly one of `display_id`, `name`, `asset_tag` or `serial_number`",
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the asset type",
+				MarkdownDescription: "Name of the asset, used to look up the asset when set",
 				Computed:            true,
+				Optional:            true,
 			},
 			"asset_tag": schema.StringAttribute{
-				MarkdownDescription: "Asset tag of the asset type",
+				MarkdownDescription: "Asset tag of the asset, used to look up the asset when set",
 				Computed:            true,
+				Optional:            true,
 			},
 			"asset_type_id": schema.Int64Attribute{
 				MarkdownDescription: "ID of the asset type",
@@ -105,8 +130,9 @@ func (d *AssetDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Computed:            true,
 			},
 			"display_id": schema.Int64Attribute{
-				MarkdownDescription: "Display ID of the asset type",
-				Required:            true,
+				MarkdownDescription: "Display ID of the asset, used to look up the asset when set",
+				Computed:            true,
+				Optional:            true,
 			},
 			"end_of_life": schema.StringAttribute{
 				MarkdownDescription: "Date and time of end of life",
@@ -140,10 +166,33 @@ func (d *AssetDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "ID of the user",
 				Computed:            true,
 			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Serial number of the asset from its `serial_number` type field, used to look up the asset when set. " +
+					"This is the only type field assets can be looked up by, as FreshService search only covers `name`, `asset_tag` and `serial_number`",
+				Computed: true,
+				Optional: true,
+			},
+			"type_fields": schema.MapAttribute{
+				MarkdownDescription: "Custom fields of the asset type keyed by field name without the asset type ID suffix, fields without a value are left out",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
 
+// ConfigValidators returns the validators for the data source configuration.
+func (d *AssetDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("display_id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("asset_tag"),
+			path.MatchRoot("serial_number"),
+		),
+	}
+}
+
 func (d *AssetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -173,11 +222,20 @@ func (d *AssetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// tflog.Info(ctx, data.DisplayID.)
-	assetDetails, err := d.client.GetAsset(data.DisplayID.ValueInt64())
+	displayID := data.DisplayID.ValueInt64()
+	if data.DisplayID.IsNull() {
+		var err error
+		displayID, err = d.lookupDisplayID(ctx, data)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error looking up asset", err)
+			return
+		}
+	}
+
+	assetDetails, err := d.client.GetAsset(ctx, displayID)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error getting asset", err.Error())
+		addAPIError(&resp.Diagnostics, "Error getting asset", err)
 		return
 	}
 
@@ -186,3 +244,73 @@ func (d *AssetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 }
+
+// assetSerialNumberField is the type field holding the serial number of
+// hardware assets, the only type field FreshService search supports.
+const assetSerialNumberField = "serial_number"
+
+// lookupDisplayID searches for the asset matching the name, asset tag or
+// serial number in data and returns its display ID. Search matches partial
+// values, so the results are narrowed down to exact matches.
+func (d *AssetDataSource) lookupDisplayID(ctx context.Context, data AssetDataSourceModel) (int64, error) {
+	var field, value string
+	switch {
+	case !data.Name.IsNull():
+		field, value = "name", data.Name.ValueString()
+	case !data.AssetTag.IsNull():
+		field, value = "asset_tag", data.AssetTag.ValueString()
+	default:
+		field, value = assetSerialNumberField, data.SerialNumber.ValueString()
+	}
+
+	options := freshclient.ListAssetsOptions{IncludeTypeFields: field == assetSerialNumberField}
+	if term := searchTerm(value); term != "" {
+		options.Search = fmt.Sprintf("%s:'%s'", field, term)
+	}
+
+	assets, err := d.client.ListAssets(ctx, options)
+	if err != nil {
+		return 0, err
+	}
+
+	var matches []int64
+	for _, asset := range assets {
+		var actual string
+		switch field {
+		case "name":
+			actual = asset.Name
+		case "asset_tag":
+			actual = asset.AssetTag
+		default:
+			actual = serialNumberValue(asset.TypeFields).ValueString()
+		}
+
+		if actual == value {
+			matches = append(matches, asset.DisplayID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no asset found with %s %q", field, value)
+	case 1:
+		return matches[0], nil
+	}
+
+	return 0, fmt.Errorf("found %d assets with %s %q, display IDs %v, use display_id to pick one", len(matches), field, value, matches)
+}
+
+// searchTerm returns the part of value to search for. Search queries are
+// quoted with ' and " and have no escape for them, so values containing
+// quotes are searched by their longest part without one. It is empty when
+// value is made of quotes only and every asset has to be listed.
+func searchTerm(value string) string {
+	var term string
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == '\'' || r == '"' }) {
+		if len(part) > len(term) {
+			term = part
+		}
+	}
+
+	return term
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AssetComponentsDataSource{}
+
+func NewAssetComponentsDataSource() datasource.DataSource {
+	return &AssetComponentsDataSource{}
+}
+
+type AssetComponentsDataSource struct {
+	client *freshclient.Client
+}
+
+type AssetComponentsDataSourceModel struct {
+	DisplayID     types.Int64                     `tfsdk:"display_id"`
+	ComponentType types.String                    `tfsdk:"component_type"`
+	Components    []AssetComponentDataSourceModel `tfsdk:"components"`
+}
+
+type AssetComponentDataSourceModel struct {
+	ComponentData     types.Map    `tfsdk:"component_data"`
+	ComponentDataJSON types.String `tfsdk:"component_data_json"`
+	ComponentType     types.String `tfsdk:"component_type"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ID                types.Int64  `tfsdk:"id"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AssetComponentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_components"
+}
+
+// fromFreshAssetComponent converts a component from the API, fields of the
+// component data without a value are left out. The component data is also
+// kept as JSON so numbers and booleans keep their type.
+func (m AssetComponentDataSourceModel) fromFreshAssetComponent(component freshclient.AssetComponentDetails) AssetComponentDataSourceModel {
+	data := map[string]attr.Value{}
+	typed := map[string]interface{}{}
+	for name, value := range component.ComponentData {
+		if value == nil {
+			continue
+		}
+		data[name] = types.StringValue(typeFieldString(value))
+		typed[name] = value
+	}
+
+	encoded, err := json.Marshal(typed)
+	if err != nil {
+		encoded = []byte("{}")
+	}
+
+	return AssetComponentDataSourceModel{
+		ComponentData:     types.MapValueMust(types.StringType, data),
+		ComponentDataJSON: types.StringValue(string(encoded)),
+		ComponentType:     types.StringValue(component.ComponentType),
+		CreatedAt:         types.StringValue(component.CreatedAt),
+		ID:                types.Int64Value(component.ID),
+		UpdatedAt:         types.StringValue(component.UpdatedAt),
+	}
+}
+
+func (d *AssetComponentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Asset Components Data Source, lists the hardware components of an asset like processors, memory, disks and network adapters",
+
+		Attributes: map[string]schema.Attribute{
+			"display_id": schema.Int64Attribute{
+				MarkdownDescription: "Display ID of the asset",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"component_type": schema.StringAttribute{
+				MarkdownDescription: "Only list components of this type, e.g. `Processor`, compared case-insensitively",
+				Optional:            true,
+			},
+			"components": schema.ListNestedAttribute{
+				MarkdownDescription: "Components of the asset",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"component_data": schema.MapAttribute{
+							MarkdownDescription: "Fields of the component as strings, which depend on the component type, e.g. `manufacturer` and `no_of_cores` of a processor",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"component_data_json": schema.StringAttribute{
+							MarkdownDescription: "Fields of the component as a JSON object keeping numbers and booleans typed, decode it with `jsondecode`",
+							Computed:            true,
+						},
+						"component_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the component, e.g. `Processor`, `Memory`, `Disk` or `Network Adapter`",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time of creation",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Unique ID of the component",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time of last update",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AssetComponentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AssetComponentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetComponentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	components, err := d.client.ListAssetComponents(ctx, data.DisplayID.ValueInt64())
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error listing asset components", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data.Components = make([]AssetComponentDataSourceModel, 0, len(components))
+	for _, component := range components {
+		if !data.ComponentType.IsNull() && !strings.EqualFold(component.ComponentType, data.ComponentType.ValueString()) {
+			continue
+		}
+		data.Components = append(data.Components, AssetComponentDataSourceModel{}.fromFreshAssetComponent(component))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetComponentsDataSource(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	host := server.AddAsset(map[string]interface{}{"name": "TestAccComponentsHost", "asset_type_id": 1})
+	server.AddAssetComponent(host, "Processor", map[string]interface{}{"manufacturer": "Intel", "no_of_cores": 16, "cpu_speed": 2.1, "model": nil})
+	server.AddAssetComponent(host, "Memory", map[string]interface{}{"capacity": "64 GB"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`
+data "fresh_asset_components" "all" {
+  display_id = %[1]d
+}
+
+data "fresh_asset_components" "processors" {
+  display_id     = %[1]d
+  component_type = "processor"
+}
+`, host),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_components.all", "components.#", "2"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.all", "components.1.component_type", "Memory"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.all", "components.1.component_data.capacity", "64 GB"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.processors", "components.#", "1"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.processors", "components.0.component_data.%", "3"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.processors", "components.0.component_data.no_of_cores", "16"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.processors", "components.0.component_data.cpu_speed", "2.1"),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.processors", "components.0.component_data_json", `{"cpu_speed":2.1,"manufacturer":"Intel","no_of_cores":16}`),
+					resource.TestCheckResourceAttr("data.fresh_asset_components.all", "components.1.component_data_json", `{"capacity":"64 GB"}`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AssetSoftwareDataSource{}
+
+func NewAssetSoftwareDataSource() datasource.DataSource {
+	return &AssetSoftwareDataSource{}
+}
+
+type AssetSoftwareDataSource struct {
+	client *freshclient.Client
+}
+
+type AssetSoftwareDataSourceModel struct {
+	DisplayID types.Int64                             `tfsdk:"display_id"`
+	Software  []AssetInstalledSoftwareDataSourceModel `tfsdk:"software"`
+}
+
+type AssetInstalledSoftwareDataSourceModel struct {
+	ApplicationType  types.String `tfsdk:"application_type"`
+	Category         types.String `tfsdk:"category"`
+	ID               types.Int64  `tfsdk:"id"`
+	InstallationDate types.String `tfsdk:"installation_date"`
+	InstallationPath types.String `tfsdk:"installation_path"`
+	Name             types.String `tfsdk:"name"`
+	Status           types.String `tfsdk:"status"`
+	Version          types.String `tfsdk:"version"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AssetSoftwareDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_software"
+}
+
+// fromFreshInstallation converts software installed on an asset, the
+// installation details are empty when FreshService has none.
+func (m AssetInstalledSoftwareDataSourceModel) fromFreshInstallation(application freshclient.ApplicationDetails, installation freshclient.InstallationDetails) AssetInstalledSoftwareDataSourceModel {
+	return AssetInstalledSoftwareDataSourceModel{
+		ApplicationType:  types.StringValue(application.ApplicationType),
+		Category:         types.StringValue(application.Category),
+		ID:               types.Int64Value(application.ID),
+		InstallationDate: types.StringValue(installation.InstallationDate),
+		InstallationPath: types.StringValue(installation.InstallationPath),
+		Name:             types.StringValue(application.Name),
+		Status:           types.StringValue(application.Status),
+		Version:          types.StringValue(installation.Version),
+	}
+}
+
+func (d *AssetSoftwareDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Asset Software Data Source, lists the software installed on an asset with the installed versions",
+
+		Attributes: map[string]schema.Attribute{
+			"display_id": schema.Int64Attribute{
+				MarkdownDescription: "Display ID of the asset",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"software": schema.ListNestedAttribute{
+				MarkdownDescription: "Software installed on the asset",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"application_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the software, `desktop`, `saas` or `mobile`",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Category of the software",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Unique ID of the software",
+							Computed:            true,
+						},
+						"installation_date": schema.StringAttribute{
+							MarkdownDescription: "Date and time of the installation on the asset",
+							Computed:            true,
+						},
+						"installation_path": schema.StringAttribute{
+							MarkdownDescription: "Path the software is installed in on the asset",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the software",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the software, `managed`, `ignored` or `blacklisted`",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version installed on the asset",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AssetSoftwareDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AssetSoftwareDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetSoftwareDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	displayID := data.DisplayID.ValueInt64()
+	applications, err := d.client.ListAssetApplications(ctx, displayID)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error listing asset software", err)
+		return
+	}
+
+	// The asset applications carry no version, it is looked up in the
+	// installations of each application.
+	data.Software = make([]AssetInstalledSoftwareDataSourceModel, 0, len(applications))
+	for _, application := range applications {
+		installations, err := d.client.ListApplicationInstallations(ctx, application.ID)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error listing software installations", err)
+			return
+		}
+
+		var installation freshclient.InstallationDetails
+		for _, candidate := range installations {
+			if candidate.InstallationMachineID == displayID {
+				installation = candidate
+				break
+			}
+		}
+		data.Software = append(data.Software, AssetInstalledSoftwareDataSourceModel{}.fromFreshInstallation(application, installation))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetSoftwareDataSource(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	laptop := server.AddAsset(map[string]interface{}{"name": "TestAccSoftwareLaptop", "asset_type_id": 1})
+	desktop := server.AddAsset(map[string]interface{}{"name": "TestAccSoftwareDesktop", "asset_type_id": 1})
+	browser := server.AddApplication("TestAcc Browser")
+	editor := server.AddApplication("TestAcc Editor")
+	server.AddApplication("TestAcc Unused")
+	server.AddInstallation(browser, desktop, "118.0")
+	server.AddInstallation(browser, laptop, "119.0")
+	server.AddInstallation(editor, laptop, "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`
+data "fresh_asset_software" "test" {
+  display_id = %d
+}
+`, laptop),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_software.test", "software.#", "2"),
+					resource.TestCheckResourceAttr("data.fresh_asset_software.test", "software.0.id", strconv.FormatInt(browser, 10)),
+					resource.TestCheckResourceAttr("data.fresh_asset_software.test", "software.0.name", "TestAcc Browser"),
+					resource.TestCheckResourceAttr("data.fresh_asset_software.test", "software.0.version", "119.0"),
+					resource.TestCheckResourceAttr("data.fresh_asset_software.test", "software.1.name", "TestAcc Editor"),
+					resource.TestCheckResourceAttr("data.fresh_asset_software.test", "software.1.version", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAssetDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fresh_asset.test", "id", "fresh_asset.test", "id"),
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "name", "TestAccAssetDataSource"),
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "description", "Read by Terraform"),
+				),
+			},
+		},
+	})
+}
+
+var testAccAssetDataSourceConfig = fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "test" {
+  name          = "TestAccAssetDataSource"
+  asset_type_id = data.fresh_asset_type.test.id
+  description   = "Read by Terraform"
+}
+
+data "fresh_asset" "test" {
+  display_id = fresh_asset.test.display_id
+}
+`, testAccAssetTypeName)
+
+func TestAccAssetDataSourceLookup(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Exactly one lookup key is required
+			{
+				Config:      testAccAssetDataSourceLookupConfig("name = \"TestAccAssetLookup-01\"\n  asset_tag = \"TAG-LOOKUP-01\"", `asset_tag = "TAG-LOOKUP-02"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Lookup by name and asset tag
+			{
+				Config: testAccAssetDataSourceLookupConfig(`name = "TestAccAssetLookup-01"`, `asset_tag = "TAG-LOOKUP-02"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fresh_asset.first", "display_id", "fresh_asset.test.0", "display_id"),
+					resource.TestCheckResourceAttr("data.fresh_asset.first", "asset_tag", "TAG-LOOKUP-01"),
+					resource.TestCheckResourceAttrPair("data.fresh_asset.second", "display_id", "fresh_asset.test.1", "display_id"),
+					resource.TestCheckResourceAttr("data.fresh_asset.second", "name", "TestAccAssetLookup-02"),
+				),
+			},
+			// Missing assets are reported
+			{
+				Config:      testAccAssetDataSourceLookupConfig(`name = "TestAccAssetLookup-01"`, `name = "TestAccAssetLookup-03"`),
+				ExpectError: regexp.MustCompile(`no asset found with name "TestAccAssetLookup-03"`),
+			},
+		},
+	})
+}
+
+func testAccAssetDataSourceLookupConfig(first string, second string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "test" {
+  count         = 2
+  name          = "TestAccAssetLookup-0${count.index + 1}"
+  asset_type_id = data.fresh_asset_type.test.id
+  asset_tag     = "TAG-LOOKUP-0${count.index + 1}"
+}
+
+data "fresh_asset" "first" {
+  %[2]s
+
+  depends_on = [fresh_asset.test]
+}
+
+data "fresh_asset" "second" {
+  %[3]s
+
+  depends_on = [fresh_asset.test]
+}
+`, testAccAssetTypeName, first, second)
+}
+
+func TestAccAssetDataSourceSerialNumber(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	serialKey := server.AddAssetTypeField(1, "serial_number", "Serial Number", "text")
+	server.AddAsset(map[string]interface{}{"name": "TestAccAssetSerial-01", "asset_type_id": 1, "type_fields": map[string]interface{}{serialKey: "SN-100"}})
+	server.AddAsset(map[string]interface{}{"name": "TestAccAssetSerial-02", "asset_type_id": 1, "type_fields": map[string]interface{}{serialKey: "SN-1000"}})
+	server.AddAsset(map[string]interface{}{"name": "TestAccAssetSerial-03", "asset_type_id": 1, "type_fields": map[string]interface{}{serialKey: "SN-1000"}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup by serial number, partial search matches are ignored
+			{
+				Config: `
+data "fresh_asset" "test" {
+  serial_number = "SN-100"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "name", "TestAccAssetSerial-01"),
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "type_fields.serial_number", "SN-100"),
+				),
+			},
+			// Several matches are reported
+			{
+				Config: `
+data "fresh_asset" "test" {
+  serial_number = "SN-1000"
+}
+`,
+				ExpectError: regexp.MustCompile(`found 2 assets with serial_number "SN-1000"`),
+			},
+		},
+	})
+}
+
+func TestAccAssetDataSourceQuotedName(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	displayID := server.AddAsset(map[string]interface{}{"name": "O'Brien's \"work\" laptop", "asset_type_id": 1})
+	server.AddAsset(map[string]interface{}{"name": "Brien's \"work\" laptop", "asset_type_id": 1})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Quotes in the value do not break the search query
+			{
+				Config: `
+data "fresh_asset" "test" {
+  name = "O'Brien's \"work\" laptop"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "display_id", strconv.FormatInt(displayID, 10)),
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "name", "O'Brien's \"work\" laptop"),
+				),
+			},
+		},
+	})
+}
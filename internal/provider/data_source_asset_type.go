@@ -2,14 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-fresh/internal/freshclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &AssetTypeDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &AssetTypeDataSource{}
 
 func NewAssetTypeDataSource() datasource.DataSource {
 	return &AssetTypeDataSource{}
@@ -27,6 +31,14 @@ type AssetTypeDataSourceModel struct {
 	ParentAssetTypeID types.Int64  `tfsdk:"parent_asset_type_id"`
 	UpdatedAt         types.String `tfsdk:"updated_at"`
 	Visible           types.Bool   `tfsdk:"visible"`
+	// Ancestry lists the parents of the asset type, nearest first
+	Ancestry          []AssetTypeAncestorModel `tfsdk:"ancestry"`
+	ChildAssetTypeIDs []types.Int64            `tfsdk:"child_asset_type_ids"`
+}
+
+type AssetTypeAncestorModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
 }
 
 // Metadata returns the metadata for the data source.
@@ -43,13 +55,37 @@ func (m AssetTypeDataSourceModel) fromFreshAssetType(assetType freshclient.Asset
 		ParentAssetTypeID: types.Int64Value(assetType.ParentAssetTypeID),
 		UpdatedAt:         types.StringValue(assetType.UpdatedAt),
 		Visible:           types.BoolValue(assetType.Visible),
+		Ancestry:          []AssetTypeAncestorModel{},
+		ChildAssetTypeIDs: []types.Int64{},
+	}
+}
+
+// withHierarchy fills in the ancestry and the children of the asset type
+// from the list of every asset type.
+func (m AssetTypeDataSourceModel) withHierarchy(assetTypes []freshclient.AssetTypeDetails, assetType freshclient.AssetTypeDetails) AssetTypeDataSourceModel {
+	m.Ancestry = []AssetTypeAncestorModel{}
+	for _, ancestor := range assetTypeAncestry(assetTypes, assetType) {
+		m.Ancestry = append(m.Ancestry, AssetTypeAncestorModel{
+			ID:   types.Int64Value(ancestor.ID),
+			Name: types.StringValue(ancestor.Name),
+		})
+	}
+
+	m.ChildAssetTypeIDs = []types.Int64{}
+	for _, other := range assetTypes {
+		if other.ParentAssetTypeID == assetType.ID {
+			m.ChildAssetTypeIDs = append(m.ChildAssetTypeIDs, types.Int64Value(other.ID))
+		}
 	}
+
+	return m
 }
 
 func (d *AssetTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Asset Type Data Source",
+		MarkdownDescription: "Asset Type Data Source, looks up an asset type by `id` or by `name`. " +
+			"Names are only unique among the children of a parent, set `parent_asset_type_id` to pick one",
 
 		Attributes: map[string]schema.Attribute{
 			"created_at": schema.StringAttribute{
@@ -63,17 +99,18 @@ func (d *AssetTypeDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Required:            false,
 			},
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Unique ID of the asset type",
+				MarkdownDescription: "Unique ID of the asset type, conflicts with `name`",
 				Computed:            true,
 				Optional:            true,
 				Required:            false,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the asset type",
-				Required:            true,
+				MarkdownDescription: "Name of the asset type, conflicts with `id`",
+				Computed:            true,
+				Optional:            true,
 			},
 			"parent_asset_type_id": schema.Int64Attribute{
-				MarkdownDescription: "ID of the parent asset type",
+				MarkdownDescription: "ID of the parent asset type, limits a lookup by `name` to the children of this asset type. `0` for root asset types",
 				Computed:            true,
 				Optional:            true,
 				Required:            false,
@@ -90,10 +127,45 @@ func (d *AssetTypeDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Optional:            true,
 				Required:            false,
 			},
+			"child_asset_type_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the direct children of the asset type",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"ancestry": schema.ListNestedAttribute{
+				MarkdownDescription: "Parents of the asset type from its direct parent up to the root asset type",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the parent asset type",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the parent asset type",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ConfigValidators returns the validators for the data source configuration.
+func (d *AssetTypeDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("parent_asset_type_id"),
+		),
+	}
+}
+
 func (d *AssetTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -124,15 +196,79 @@ func (d *AssetTypeDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	assetTypeDetails, err := d.client.GetAssetType(data.Name.ValueString())
+	// Asset types are few, list them all to resolve names and the hierarchy.
+	assetTypes, err := d.client.ListAssetTypes(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting asset type", err)
+		return
+	}
 
+	assetType, err := findAssetType(assetTypes, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting asset type", err.Error())
 		return
 	}
 
 	// Save data into Terraform state
-	data = data.fromFreshAssetType(*assetTypeDetails)
+	data = data.fromFreshAssetType(*assetType).withHierarchy(assetTypes, *assetType)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findAssetType returns the asset type matching the id, or the name and
+// optional parent, of the configuration.
+func findAssetType(assetTypes []freshclient.AssetTypeDetails, data AssetTypeDataSourceModel) (*freshclient.AssetTypeDetails, error) {
+	var matches []freshclient.AssetTypeDetails
+	for _, assetType := range assetTypes {
+		switch {
+		case !data.ID.IsNull():
+			if assetType.ID != data.ID.ValueInt64() {
+				continue
+			}
+		case assetType.Name != data.Name.ValueString():
+			continue
+		case !data.ParentAssetTypeID.IsNull() && assetType.ParentAssetTypeID != data.ParentAssetTypeID.ValueInt64():
+			continue
+		}
+		matches = append(matches, assetType)
+	}
+
+	switch {
+	case len(matches) == 1:
+		return &matches[0], nil
+	case len(matches) > 1:
+		parentIDs := make([]int64, 0, len(matches))
+		for _, match := range matches {
+			parentIDs = append(parentIDs, match.ParentAssetTypeID)
+		}
+		return nil, fmt.Errorf("found %d asset types named %q with parent asset type IDs %v, set parent_asset_type_id or use id to pick one", len(matches), data.Name.ValueString(), parentIDs)
+	case !data.ID.IsNull():
+		return nil, fmt.Errorf("asset type %d not found", data.ID.ValueInt64())
+	case !data.ParentAssetTypeID.IsNull():
+		return nil, fmt.Errorf("asset type %s not found below parent asset type %d", data.Name.ValueString(), data.ParentAssetTypeID.ValueInt64())
+	}
+
+	return nil, fmt.Errorf("asset type %s not found", data.Name.ValueString())
+}
+
+// assetTypeAncestry returns the parents of an asset type from its direct
+// parent up to the root.
+func assetTypeAncestry(assetTypes []freshclient.AssetTypeDetails, assetType freshclient.AssetTypeDetails) []freshclient.AssetTypeDetails {
+	byID := make(map[int64]freshclient.AssetTypeDetails, len(assetTypes))
+	for _, other := range assetTypes {
+		byID[other.ID] = other
+	}
+
+	var ancestry []freshclient.AssetTypeDetails
+	seen := map[int64]bool{assetType.ID: true}
+	for parentID := assetType.ParentAssetTypeID; parentID != 0 && !seen[parentID]; {
+		parent, ok := byID[parentID]
+		if !ok {
+			break
+		}
+		seen[parentID] = true
+		ancestry = append(ancestry, parent)
+		parentID = parent.ParentAssetTypeID
+	}
 
+	return ancestry
 }
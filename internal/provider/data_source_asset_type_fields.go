@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AssetTypeFieldsDataSource{}
+
+func NewAssetTypeFieldsDataSource() datasource.DataSource {
+	return &AssetTypeFieldsDataSource{}
+}
+
+type AssetTypeFieldsDataSource struct {
+	client *freshclient.Client
+}
+
+type AssetTypeFieldsDataSourceModel struct {
+	AssetTypeID types.Int64                     `tfsdk:"asset_type_id"`
+	Fields      []AssetTypeFieldDataSourceModel `tfsdk:"fields"`
+}
+
+type AssetTypeFieldDataSourceModel struct {
+	AssetTypeID types.Int64    `tfsdk:"asset_type_id"`
+	Choices     []types.String `tfsdk:"choices"`
+	DataType    types.String   `tfsdk:"data_type"`
+	FieldHeader types.String   `tfsdk:"field_header"`
+	FieldType   types.String   `tfsdk:"field_type"`
+	ID          types.Int64    `tfsdk:"id"`
+	Key         types.String   `tfsdk:"key"`
+	Label       types.String   `tfsdk:"label"`
+	Mandatory   types.Bool     `tfsdk:"mandatory"`
+	Name        types.String   `tfsdk:"name"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AssetTypeFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_type_fields"
+}
+
+func (m AssetTypeFieldDataSourceModel) fromFreshAssetTypeField(field freshclient.AssetTypeFieldDetails) AssetTypeFieldDataSourceModel {
+	choices := make([]types.String, 0, len(field.Choices))
+	for _, choice := range field.ChoiceValues() {
+		choices = append(choices, types.StringValue(choice))
+	}
+
+	return AssetTypeFieldDataSourceModel{
+		AssetTypeID: types.Int64Value(field.AssetTypeID),
+		Choices:     choices,
+		DataType:    types.StringValue(field.DataType),
+		FieldHeader: types.StringValue(field.FieldHeader),
+		FieldType:   types.StringValue(field.FieldType),
+		ID:          types.Int64Value(field.ID),
+		Key:         types.StringValue(field.Name),
+		Label:       types.StringValue(field.Label),
+		Mandatory:   types.BoolValue(field.Mandatory),
+		Name:        types.StringValue(field.PlainName()),
+	}
+}
+
+func (d *AssetTypeFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Asset Type Fields Data Source, lists the type fields of an asset type including the ones inherited from its parents",
+
+		Attributes: map[string]schema.Attribute{
+			"asset_type_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the asset type",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Type fields of the asset type, default asset fields like `name` are not listed",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"asset_type_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the asset type defining the field, a parent for inherited fields",
+							Computed:            true,
+						},
+						"choices": schema.ListAttribute{
+							MarkdownDescription: "Values accepted by a dropdown field",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"data_type": schema.StringAttribute{
+							MarkdownDescription: "Data type of the field, e.g. `text`, `number` or `dropdown`",
+							Computed:            true,
+						},
+						"field_header": schema.StringAttribute{
+							MarkdownDescription: "Section the field is listed in",
+							Computed:            true,
+						},
+						"field_type": schema.StringAttribute{
+							MarkdownDescription: "Field type, e.g. `custom_text`",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Unique ID of the field",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Name of the field as sent to the API, with the asset type ID suffix",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Label of the field",
+							Computed:            true,
+						},
+						"mandatory": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is required",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the field without the asset type ID suffix, as used in `type_fields`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AssetTypeFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AssetTypeFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetTypeFieldsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := d.client.GetAssetTypeFields(ctx, data.AssetTypeID.ValueInt64())
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting asset type fields", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data.Fields = make([]AssetTypeFieldDataSourceModel, 0, len(fields))
+	for _, field := range fields {
+		if field.IsTypeField() {
+			data.Fields = append(data.Fields, AssetTypeFieldDataSourceModel{}.fromFreshAssetTypeField(field))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetTypeFieldsDataSource(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	childID := server.AddAssetType("TestAccChildAssetType", 1)
+	serialNumberKey := server.AddAssetTypeField(1, "serial_number", "Serial Number", "text")
+	environmentKey := server.AddAssetTypeField(childID, "environment", "Environment", "text")
+	server.SetAssetTypeFieldChoices(childID, environmentKey, "production", "staging")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+data "fresh_asset_type" "test" {
+  name = "TestAccChildAssetType"
+}
+
+data "fresh_asset_type_fields" "test" {
+  asset_type_id = data.fresh_asset_type.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_type_fields.test", "fields.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fresh_asset_type_fields.test", "fields.*", map[string]string{
+						"name":          "serial_number",
+						"key":           serialNumberKey,
+						"label":         "Serial Number",
+						"data_type":     "text",
+						"asset_type_id": "1",
+						"field_header":  testAccAssetTypeName,
+						"choices.#":     "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fresh_asset_type_fields.test", "fields.*", map[string]string{
+						"name":      "environment",
+						"key":       environmentKey,
+						"data_type": "dropdown",
+						"choices.#": "2",
+						"choices.0": "production",
+						"choices.1": "staging",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetTypeDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %q
+}
+`, testAccAssetTypeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_type.test", "name", testAccAssetTypeName),
+					resource.TestCheckResourceAttrSet("data.fresh_asset_type.test", "id"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.test", "visible", "true"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.test", "ancestry.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAssetTypeDataSourceLookup(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	hardwareID := server.AddAssetType("TestAccHardware", 0)
+	serverID := server.AddAssetType("TestAccServer", hardwareID)
+	server.AddAssetType("TestAccDuplicate", hardwareID)
+	duplicateID := server.AddAssetType("TestAccDuplicate", serverID)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Names shared by several asset types are rejected
+			{
+				Config: `
+data "fresh_asset_type" "test" {
+  name = "TestAccDuplicate"
+}
+`,
+				ExpectError: regexp.MustCompile(`found 2 asset types named "TestAccDuplicate"`),
+			},
+			// Lookup by name below a parent and by ID
+			{
+				Config: fmt.Sprintf(`
+data "fresh_asset_type" "by_name" {
+  name                 = "TestAccDuplicate"
+  parent_asset_type_id = %[1]d
+}
+
+data "fresh_asset_type" "by_id" {
+  id = %[2]d
+}
+`, serverID, duplicateID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_name", "id", fmt.Sprint(duplicateID)),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_name", "ancestry.#", "2"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_name", "ancestry.0.id", fmt.Sprint(serverID)),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_name", "ancestry.0.name", "TestAccServer"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_name", "ancestry.1.name", "TestAccHardware"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_id", "name", "TestAccDuplicate"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_id", "parent_asset_type_id", fmt.Sprint(serverID)),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.by_id", "ancestry.#", "2"),
+				),
+			},
+			// Root asset types have no ancestry but list their children
+			{
+				Config: `
+data "fresh_asset_type" "test" {
+  name                 = "TestAccHardware"
+  parent_asset_type_id = 0
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_type.test", "ancestry.#", "0"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.test", "child_asset_type_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.fresh_asset_type.test", "child_asset_type_ids.0", fmt.Sprint(serverID)),
+				),
+			},
+		},
+	})
+}
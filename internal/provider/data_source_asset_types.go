@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AssetTypesDataSource{}
+
+func NewAssetTypesDataSource() datasource.DataSource {
+	return &AssetTypesDataSource{}
+}
+
+type AssetTypesDataSource struct {
+	client *freshclient.Client
+}
+
+type AssetTypesDataSourceModel struct {
+	ParentAssetTypeID types.Int64                `tfsdk:"parent_asset_type_id"`
+	Visible           types.Bool                 `tfsdk:"visible"`
+	AssetTypes        []AssetTypeDataSourceModel `tfsdk:"asset_types"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AssetTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_types"
+}
+
+func (d *AssetTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Asset Types Data Source, lists every asset type with its ancestry and children to walk the asset type tree",
+
+		Attributes: map[string]schema.Attribute{
+			"parent_asset_type_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list the direct children of this asset type, `0` lists the root asset types",
+				Optional:            true,
+			},
+			"visible": schema.BoolAttribute{
+				MarkdownDescription: "Only list visible or hidden asset types",
+				Optional:            true,
+			},
+			"asset_types": schema.ListNestedAttribute{
+				MarkdownDescription: "Asset types matching the filters",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAssetTypeAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// computedAssetTypeAttributes returns the attributes of an asset type as
+// read-only data source attributes.
+func computedAssetTypeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time of creation",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the asset type",
+			Computed:            true,
+		},
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Unique ID of the asset type",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the asset type",
+			Computed:            true,
+		},
+		"parent_asset_type_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the parent asset type, `0` for root asset types",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time of last update",
+			Computed:            true,
+		},
+		"visible": schema.BoolAttribute{
+			MarkdownDescription: "Whether the asset type is visible",
+			Computed:            true,
+		},
+		"child_asset_type_ids": schema.ListAttribute{
+			MarkdownDescription: "IDs of the direct children of the asset type",
+			ElementType:         types.Int64Type,
+			Computed:            true,
+		},
+		"ancestry": schema.ListNestedAttribute{
+			MarkdownDescription: "Parents of the asset type from its direct parent up to the root asset type",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the parent asset type",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the parent asset type",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AssetTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AssetTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetTypes, err := d.client.ListAssetTypes(ctx)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error listing asset types", err)
+		return
+	}
+
+	// Save data into Terraform state, the hierarchy is resolved against every
+	// asset type so filtered out parents still show up in the ancestry.
+	data.AssetTypes = make([]AssetTypeDataSourceModel, 0, len(assetTypes))
+	for _, assetType := range assetTypes {
+		if !data.ParentAssetTypeID.IsNull() && assetType.ParentAssetTypeID != data.ParentAssetTypeID.ValueInt64() {
+			continue
+		}
+		if !data.Visible.IsNull() && assetType.Visible != data.Visible.ValueBool() {
+			continue
+		}
+		data.AssetTypes = append(data.AssetTypes, AssetTypeDataSourceModel{}.fromFreshAssetType(assetType).withHierarchy(assetTypes, assetType))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetTypesDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAssetTypesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_asset_types.children", "asset_types.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fresh_asset_types.children", "asset_types.*", map[string]string{
+						"name":       "TestAccAssetTypesVisible",
+						"visible":    "true",
+						"ancestry.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fresh_asset_types.children", "asset_types.*", map[string]string{
+						"name":    "TestAccAssetTypesHidden",
+						"visible": "false",
+					}),
+					resource.TestCheckResourceAttr("data.fresh_asset_types.hidden", "asset_types.#", "1"),
+					resource.TestCheckResourceAttr("data.fresh_asset_types.hidden", "asset_types.0.name", "TestAccAssetTypesHidden"),
+					resource.TestCheckResourceAttrPair("data.fresh_asset_types.hidden", "asset_types.0.ancestry.0.id", "fresh_asset_type.parent", "id"),
+					resource.TestCheckResourceAttr("data.fresh_asset_types.parent", "asset_types.#", "1"),
+					resource.TestCheckResourceAttr("data.fresh_asset_types.parent", "asset_types.0.child_asset_type_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("data.fresh_asset_types.parent", "asset_types.0.child_asset_type_ids.*", "fresh_asset_type.hidden", "id"),
+					resource.TestCheckResourceAttrWith("data.fresh_asset_types.all", "asset_types.#", func(value string) error {
+						if value == "0" || value == "1" {
+							return fmt.Errorf("asset_types.# = %s, want every asset type", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetTypesDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset_type" "parent" {
+  name                 = "TestAccAssetTypesParent"
+  parent_asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset_type" "visible" {
+  name                 = "TestAccAssetTypesVisible"
+  parent_asset_type_id = fresh_asset_type.parent.id
+}
+
+resource "fresh_asset_type" "hidden" {
+  name                 = "TestAccAssetTypesHidden"
+  parent_asset_type_id = fresh_asset_type.parent.id
+  visible              = false
+}
+
+data "fresh_asset_types" "children" {
+  parent_asset_type_id = fresh_asset_type.parent.id
+  depends_on           = [fresh_asset_type.visible, fresh_asset_type.hidden]
+}
+
+data "fresh_asset_types" "hidden" {
+  parent_asset_type_id = fresh_asset_type.parent.id
+  visible              = false
+  depends_on           = [fresh_asset_type.visible, fresh_asset_type.hidden]
+}
+
+data "fresh_asset_types" "parent" {
+  parent_asset_type_id = data.fresh_asset_type.test.id
+  depends_on           = [fresh_asset_type.visible, fresh_asset_type.hidden]
+}
+
+data "fresh_asset_types" "all" {
+  depends_on = [fresh_asset_type.visible, fresh_asset_type.hidden]
+}
+`, testAccAssetTypeName)
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AssetsDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &AssetsDataSource{}
+
+func NewAssetsDataSource() datasource.DataSource {
+	return &AssetsDataSource{}
+}
+
+type AssetsDataSource struct {
+	client *freshclient.Client
+}
+
+type AssetsDataSourceModel struct {
+	Filter            types.String           `tfsdk:"filter"`
+	Search            types.String           `tfsdk:"search"`
+	IncludeTypeFields types.Bool             `tfsdk:"include_type_fields"`
+	Assets            []AssetDataSourceModel `tfsdk:"assets"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *AssetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assets"
+}
+
+func (d *AssetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assets Data Source, lists every asset matching a filter or search query",
+
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "FreshService filter query, e.g. `asset_type_id:123 AND location_id:5`",
+				Optional:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "FreshService search query on `name`, `asset_tag` or `serial_number`, e.g. `name:'web-'`",
+				Optional:            true,
+			},
+			"include_type_fields": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return the type fields of every asset",
+				Optional:            true,
+			},
+			"assets": schema.ListNestedAttribute{
+				MarkdownDescription: "Assets matching the query",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAssetAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// computedAssetAttributes returns the attributes of an asset as read-only
+// data source attributes.
+func computedAssetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the asset",
+			Computed:            true,
+		},
+		"asset_tag": schema.StringAttribute{
+			MarkdownDescription: "Asset tag of the asset",
+			Computed:            true,
+		},
+		"asset_type_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the asset type",
+			Computed:            true,
+		},
+		"assigned_on": schema.StringAttribute{
+			MarkdownDescription: "Date and time of assignment",
+			Computed:            true,
+		},
+		"author_type": schema.StringAttribute{
+			MarkdownDescription: "Type of author",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time of creation",
+			Computed:            true,
+		},
+		"department_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the department",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the asset",
+			Computed:            true,
+		},
+		"display_id": schema.Int64Attribute{
+			MarkdownDescription: "Display ID of the asset",
+			Computed:            true,
+		},
+		"end_of_life": schema.StringAttribute{
+			MarkdownDescription: "Date and time of end of life",
+			Computed:            true,
+		},
+		"group_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the group",
+			Computed:            true,
+		},
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "Unique ID of the asset",
+			Computed:            true,
+		},
+		"impact": schema.StringAttribute{
+			MarkdownDescription: "Impact of the asset",
+			Computed:            true,
+		},
+		"location_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the location",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time of last update",
+			Computed:            true,
+		},
+		"usage_type": schema.StringAttribute{
+			MarkdownDescription: "Usage type of the asset",
+			Computed:            true,
+		},
+		"user_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the user",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "Serial number of the asset from its `serial_number` type field, only set with `include_type_fields`",
+			Computed:            true,
+		},
+		"type_fields": schema.MapAttribute{
+			MarkdownDescription: "Custom fields of the asset type keyed by field name without the asset type ID suffix, only set with `include_type_fields`",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+// ConfigValidators returns the validators for the data source configuration.
+func (d *AssetsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("filter"),
+			path.MatchRoot("search"),
+		),
+	}
+}
+
+func (d *AssetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *AssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AssetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assets, err := d.client.ListAssets(ctx, freshclient.ListAssetsOptions{
+		Filter:            data.Filter.ValueString(),
+		Search:            data.Search.ValueString(),
+		IncludeTypeFields: data.IncludeTypeFields.ValueBool(),
+	})
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error listing assets", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data.Assets = make([]AssetDataSourceModel, 0, len(assets))
+	for _, asset := range assets {
+		data.Assets = append(data.Assets, AssetDataSourceModel{}.fromFreshAsset(asset))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
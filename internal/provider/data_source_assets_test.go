@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAssetsDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Search testing
+			{
+				Config: testAccAssetsDataSourceConfig(`search = "name:'TestAccAssets-web'"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_assets.test", "assets.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fresh_assets.test", "assets.*", map[string]string{"name": "TestAccAssets-web-01"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.fresh_assets.test", "assets.*", map[string]string{"name": "TestAccAssets-web-02"}),
+					resource.TestCheckNoResourceAttr("data.fresh_assets.test", "assets.0.type_fields"),
+				),
+			},
+			// Filter testing
+			{
+				Config: testAccAssetsDataSourceConfig(`
+  filter              = "asset_type_id:${data.fresh_asset_type.test.id} AND impact:'high'"
+  include_type_fields = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fresh_assets.test", "assets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.fresh_assets.test", "assets.0.display_id", "fresh_asset.db", "display_id"),
+					resource.TestCheckResourceAttrSet("data.fresh_assets.test", "assets.0.type_fields.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetsDataSourceConfig(query string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "web" {
+  count         = 2
+  name          = "TestAccAssets-web-0${count.index + 1}"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset" "db" {
+  name          = "TestAccAssets-db-01"
+  asset_type_id = data.fresh_asset_type.test.id
+  impact        = "high"
+}
+
+data "fresh_assets" "test" {
+  %[2]s
+
+  depends_on = [fresh_asset.web, fresh_asset.db]
+}
+`, testAccAssetTypeName, query)
+}
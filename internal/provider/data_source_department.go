@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DepartmentDataSource{}
+
+func NewDepartmentDataSource() datasource.DataSource {
+	return &DepartmentDataSource{}
+}
+
+type DepartmentDataSource struct {
+	client *freshclient.Client
+}
+
+type DepartmentDataSourceModel struct {
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	CustomFields types.Map      `tfsdk:"custom_fields"`
+	Description  types.String   `tfsdk:"description"`
+	Domains      []types.String `tfsdk:"domains"`
+	HeadUserID   types.Int64    `tfsdk:"head_user_id"`
+	ID           types.Int64    `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	PrimeUserID  types.Int64    `tfsdk:"prime_user_id"`
+	UpdatedAt    types.String   `tfsdk:"updated_at"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *DepartmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_department"
+}
+
+func (m DepartmentDataSourceModel) fromFreshDepartment(department freshclient.DepartmentDetails) DepartmentDataSourceModel {
+	domains := make([]types.String, 0, len(department.Domains))
+	for _, domain := range department.Domains {
+		domains = append(domains, types.StringValue(domain))
+	}
+
+	return DepartmentDataSourceModel{
+		CreatedAt:    types.StringValue(department.CreatedAt),
+		CustomFields: customFieldsValue(department.CustomFields),
+		Description:  types.StringValue(department.Description),
+		Domains:      domains,
+		HeadUserID:   optionalInt64(department.HeadUserID),
+		ID:           types.Int64Value(department.ID),
+		Name:         types.StringValue(department.Name),
+		PrimeUserID:  optionalInt64(department.PrimeUserID),
+		UpdatedAt:    types.StringValue(department.UpdatedAt),
+	}
+}
+
+func (d *DepartmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Department Data Source, looks up a department by name",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+			},
+			"custom_fields": schema.MapAttribute{
+				MarkdownDescription: "Custom department fields by name, fields without a value are left out",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the department",
+				Computed:            true,
+			},
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "Email domains of the department",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"head_user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user heading the department",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the department",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the department, compared case-insensitively",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"prime_user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the prime user of the department",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DepartmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *DepartmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DepartmentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	departments, err := d.client.ListDepartments(ctx)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting department", err)
+		return
+	}
+
+	// Department names are unique regardless of case, the configured name is
+	// kept as it was written.
+	name := data.Name
+	for _, department := range departments {
+		if strings.EqualFold(department.Name, name.ValueString()) {
+			// Save data into Terraform state
+			data = data.fromFreshDepartment(department)
+			data.Name = name
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError("Error getting department", fmt.Sprintf("department %s not found", name.ValueString()))
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDepartmentDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "fresh_department" "test" {
+  name        = "TestAcc Legal"
+  description = "Contracts"
+  domains     = ["legal.example.com"]
+}
+
+data "fresh_department" "test" {
+  name = lower(fresh_department.test.name)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fresh_department.test", "id", "fresh_department.test", "id"),
+					resource.TestCheckResourceAttr("data.fresh_department.test", "name", "testacc legal"),
+					resource.TestCheckResourceAttr("data.fresh_department.test", "description", "Contracts"),
+					resource.TestCheckResourceAttr("data.fresh_department.test", "domains.0", "legal.example.com"),
+				),
+			},
+			// Unknown name testing
+			{
+				Config: `
+data "fresh_department" "test" {
+  name = "TestAcc Missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`department TestAcc Missing not found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// locationPathSeparator separates the names of the locations in a path.
+const locationPathSeparator = "/"
+
+var _ datasource.DataSource = &LocationDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &LocationDataSource{}
+
+func NewLocationDataSource() datasource.DataSource {
+	return &LocationDataSource{}
+}
+
+type LocationDataSource struct {
+	client *freshclient.Client
+}
+
+type LocationDataSourceModel struct {
+	City             types.String `tfsdk:"city"`
+	Country          types.String `tfsdk:"country"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	ID               types.Int64  `tfsdk:"id"`
+	Line1            types.String `tfsdk:"line1"`
+	Line2            types.String `tfsdk:"line2"`
+	Name             types.String `tfsdk:"name"`
+	ParentLocationID types.Int64  `tfsdk:"parent_location_id"`
+	Path             types.String `tfsdk:"path"`
+	PrimaryContactID types.Int64  `tfsdk:"primary_contact_id"`
+	State            types.String `tfsdk:"state"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Zipcode          types.String `tfsdk:"zipcode"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *LocationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location"
+}
+
+func (m LocationDataSourceModel) fromFreshLocation(location freshclient.LocationDetails, locationPath string) LocationDataSourceModel {
+	return LocationDataSourceModel{
+		City:             types.StringValue(location.Address.City),
+		Country:          types.StringValue(location.Address.Country),
+		CreatedAt:        types.StringValue(location.CreatedAt),
+		ID:               types.Int64Value(location.ID),
+		Line1:            types.StringValue(location.Address.Line1),
+		Line2:            types.StringValue(location.Address.Line2),
+		Name:             types.StringValue(location.Name),
+		ParentLocationID: optionalInt64(location.ParentLocationID),
+		Path:             types.StringValue(locationPath),
+		PrimaryContactID: optionalInt64(location.PrimaryContactID),
+		State:            types.StringValue(location.Address.State),
+		UpdatedAt:        types.StringValue(location.UpdatedAt),
+		Zipcode:          types.StringValue(location.Address.Zipcode),
+	}
+}
+
+func (d *LocationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Location Data Source, looks up a location by name or by its path from the top level location",
+
+		Attributes: map[string]schema.Attribute{
+			"city": schema.StringAttribute{
+				MarkdownDescription: "City of the address",
+				Computed:            true,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "Country of the address",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the location",
+				Computed:            true,
+			},
+			"line1": schema.StringAttribute{
+				MarkdownDescription: "First line of the address",
+				Computed:            true,
+			},
+			"line2": schema.StringAttribute{
+				MarkdownDescription: "Second line of the address",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the location, has to be unique, use `path` otherwise",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parent_location_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the parent location, null for top level locations",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Names of the location and its parents from the top level location down, separated by `/`, e.g. `EU/Berlin/Office 3`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"primary_contact_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user who is the primary contact of the location",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the address",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+			"zipcode": schema.StringAttribute{
+				MarkdownDescription: "Zip code of the address",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires looking up the location either by name or by path.
+func (d *LocationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("path"),
+		),
+	}
+}
+
+func (d *LocationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LocationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// List every location to resolve the paths.
+	locations, err := d.client.ListLocations(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting location", err)
+		return
+	}
+
+	location, locationPath, err := findLocation(locations, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting location", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshLocation(*location, locationPath)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findLocation returns the location matching the name or the path of the
+// configuration together with its path.
+func findLocation(locations []freshclient.LocationDetails, data LocationDataSourceModel) (*freshclient.LocationDetails, string, error) {
+	paths := locationPaths(locations)
+
+	var matches []int
+	var matchPaths []string
+	for i, location := range locations {
+		switch {
+		case !data.Path.IsNull():
+			if paths[location.ID] != data.Path.ValueString() {
+				continue
+			}
+		case location.Name != data.Name.ValueString():
+			continue
+		}
+		matches = append(matches, i)
+		matchPaths = append(matchPaths, paths[location.ID])
+	}
+
+	switch {
+	case len(matches) == 1:
+		return &locations[matches[0]], matchPaths[0], nil
+	case len(matches) > 1 && data.Path.IsNull():
+		return nil, "", fmt.Errorf("found %d locations named %q at %s, use path to pick one", len(matches), data.Name.ValueString(), strings.Join(matchPaths, ", "))
+	case len(matches) > 1:
+		return nil, "", fmt.Errorf("found %d locations at path %s", len(matches), data.Path.ValueString())
+	case !data.Path.IsNull():
+		return nil, "", fmt.Errorf("location %s not found", data.Path.ValueString())
+	}
+
+	return nil, "", fmt.Errorf("location %s not found", data.Name.ValueString())
+}
+
+// locationPaths returns the path of every location by ID, the names of the
+// location and its parents from the top level location down joined by
+// locationPathSeparator.
+func locationPaths(locations []freshclient.LocationDetails) map[int64]string {
+	byID := make(map[int64]freshclient.LocationDetails, len(locations))
+	for _, location := range locations {
+		byID[location.ID] = location
+	}
+
+	paths := make(map[int64]string, len(locations))
+	for _, location := range locations {
+		names := []string{location.Name}
+		seen := map[int64]bool{location.ID: true}
+		for parentID := location.ParentLocationID; parentID != 0 && !seen[parentID]; {
+			parent, ok := byID[parentID]
+			if !ok {
+				break
+			}
+			seen[parentID] = true
+			names = append([]string{parent.Name}, names...)
+			parentID = parent.ParentLocationID
+		}
+		paths[location.ID] = strings.Join(names, locationPathSeparator)
+	}
+
+	return paths
+}
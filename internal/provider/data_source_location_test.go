@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLocationDataSource(t *testing.T) {
+	testAccSetup(t)
+
+	locations := `
+resource "fresh_location" "eu" {
+  name = "TestAcc EU"
+}
+
+resource "fresh_location" "berlin" {
+  name               = "TestAcc Berlin"
+  parent_location_id = fresh_location.eu.id
+}
+
+resource "fresh_location" "berlin_office" {
+  name               = "TestAcc Office 3"
+  parent_location_id = fresh_location.berlin.id
+  city               = "Berlin"
+}
+
+resource "fresh_location" "munich" {
+  name               = "TestAcc Munich"
+  parent_location_id = fresh_location.eu.id
+}
+
+resource "fresh_location" "munich_office" {
+  name               = "TestAcc Office 3"
+  parent_location_id = fresh_location.munich.id
+  city               = "Munich"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: locations + `
+data "fresh_location" "by_path" {
+  path = "TestAcc EU/TestAcc Berlin/${fresh_location.berlin_office.name}"
+}
+
+data "fresh_location" "by_name" {
+  name = fresh_location.munich.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.fresh_location.by_path", "id", "fresh_location.berlin_office", "id"),
+					resource.TestCheckResourceAttrPair("data.fresh_location.by_path", "parent_location_id", "fresh_location.berlin", "id"),
+					resource.TestCheckResourceAttr("data.fresh_location.by_path", "name", "TestAcc Office 3"),
+					resource.TestCheckResourceAttr("data.fresh_location.by_path", "city", "Berlin"),
+					resource.TestCheckResourceAttrPair("data.fresh_location.by_name", "id", "fresh_location.munich", "id"),
+					resource.TestCheckResourceAttr("data.fresh_location.by_name", "path", "TestAcc EU/TestAcc Munich"),
+				),
+			},
+			// Ambiguous name testing
+			{
+				Config: locations + `
+data "fresh_location" "by_name" {
+  name = fresh_location.munich_office.name
+}
+`,
+				ExpectError: regexp.MustCompile(`found 2 locations named "TestAcc Office 3"`),
+			},
+		},
+	})
+}
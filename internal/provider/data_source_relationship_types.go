@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RelationshipTypesDataSource{}
+
+func NewRelationshipTypesDataSource() datasource.DataSource {
+	return &RelationshipTypesDataSource{}
+}
+
+type RelationshipTypesDataSource struct {
+	client *freshclient.Client
+}
+
+type RelationshipTypesDataSourceModel struct {
+	RelationshipTypes []RelationshipTypeDataSourceModel `tfsdk:"relationship_types"`
+}
+
+type RelationshipTypeDataSourceModel struct {
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Description        types.String `tfsdk:"description"`
+	DownstreamRelation types.String `tfsdk:"downstream_relation"`
+	ID                 types.Int64  `tfsdk:"id"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	UpstreamRelation   types.String `tfsdk:"upstream_relation"`
+}
+
+// Metadata returns the metadata for the data source.
+func (d *RelationshipTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationship_types"
+}
+
+func (m RelationshipTypeDataSourceModel) fromFreshRelationshipType(relationshipType freshclient.RelationshipTypeDetails) RelationshipTypeDataSourceModel {
+	return RelationshipTypeDataSourceModel{
+		CreatedAt:          types.StringValue(relationshipType.CreatedAt),
+		Description:        types.StringValue(relationshipType.Description),
+		DownstreamRelation: types.StringValue(relationshipType.DownstreamRelation),
+		ID:                 types.Int64Value(relationshipType.ID),
+		UpdatedAt:          types.StringValue(relationshipType.UpdatedAt),
+		UpstreamRelation:   types.StringValue(relationshipType.UpstreamRelation),
+	}
+}
+
+func (d *RelationshipTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Relationship Types Data Source, lists every relationship type",
+
+		Attributes: map[string]schema.Attribute{
+			"relationship_types": schema.ListNestedAttribute{
+				MarkdownDescription: "Relationship types",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time of creation",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the relationship type",
+							Computed:            true,
+						},
+						"downstream_relation": schema.StringAttribute{
+							MarkdownDescription: "Relation from the primary to the secondary entity, e.g. `Runs On`",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Unique ID of the relationship type",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time of last update",
+							Computed:            true,
+						},
+						"upstream_relation": schema.StringAttribute{
+							MarkdownDescription: "Relation from the secondary to the primary entity, e.g. `Runs`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RelationshipTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *freshclient.Client, got: %T. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read the data source and convert it into a resource object.
+func (d *RelationshipTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RelationshipTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationshipTypes, err := d.client.ListRelationshipTypes(ctx)
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error listing relationship types", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data.RelationshipTypes = make([]RelationshipTypeDataSourceModel, 0, len(relationshipTypes))
+	for _, relationshipType := range relationshipTypes {
+		data.RelationshipTypes = append(data.RelationshipTypes, RelationshipTypeDataSourceModel{}.fromFreshRelationshipType(relationshipType))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
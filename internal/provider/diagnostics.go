@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fieldPathFunc returns the attribute path of a field reported by the API,
+// or false when the field has no matching attribute.
+type fieldPathFunc func(field string) (path.Path, bool)
+
+// addAPIError adds err to diags. Field errors returned by the FreshService
+// API are reported with the name of the field.
+func addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	addAPIFieldError(diags, summary, err, nil)
+}
+
+// addAPIFieldError adds err to diags. Field errors returned by the
+// FreshService API are attached to the attribute returned by fieldPath so
+// Terraform points at the offending argument, fields without an attribute
+// are reported with the name of the field.
+func addAPIFieldError(diags *diag.Diagnostics, summary string, err error, fieldPath fieldPathFunc) {
+	var apiErr *freshclient.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	for _, fieldErr := range apiErr.Errors {
+		message := fieldErr.Message
+		if fieldErr.Code != "" {
+			message += fmt.Sprintf(" (%s)", fieldErr.Code)
+		}
+
+		if fieldErr.Field == "" {
+			diags.AddError(summary, fmt.Sprintf("%s: %s", apiErr.Description, message))
+			continue
+		}
+
+		if fieldPath != nil {
+			if attributePath, ok := fieldPath(fieldErr.Field); ok {
+				diags.AddAttributeError(attributePath, summary, fmt.Sprintf("%s: %s", apiErr.Description, message))
+				continue
+			}
+		}
+		diags.AddError(summary, fmt.Sprintf("%s: %s: %s", apiErr.Description, fieldErr.Field, message))
+	}
+}
+
+// attributeFieldPath returns a fieldPathFunc for the attributes of model, a
+// struct with tfsdk tags. Dotted fields such as custom_fields.cost map to the
+// key of a map attribute.
+func attributeFieldPath(model interface{}) fieldPathFunc {
+	attributes := map[string]reflect.Type{}
+	modelType := reflect.TypeOf(model)
+	for i := 0; i < modelType.NumField(); i++ {
+		if name := modelType.Field(i).Tag.Get("tfsdk"); name != "" && name != "-" {
+			attributes[name] = modelType.Field(i).Type
+		}
+	}
+
+	return func(field string) (path.Path, bool) {
+		name, key, dotted := strings.Cut(field, ".")
+		attributeType, ok := attributes[name]
+		switch {
+		case !ok:
+			return path.Empty(), false
+		case !dotted:
+			return path.Root(name), true
+		case attributeType == reflect.TypeOf(types.Map{}):
+			return path.Root(name).AtMapKey(key), true
+		}
+
+		return path.Empty(), false
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// TestAddAPIFieldError tests that field errors are attached to existing
+// attributes only and reported without a path otherwise.
+func TestAddAPIFieldError(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     string
+		fieldPath fieldPathFunc
+		want      path.Path
+	}{
+		{"asset attribute", "impact", assetFieldPath, path.Root("impact")},
+		{"asset type field", "cost_21000123456", assetFieldPath, path.Root("type_fields").AtMapKey("cost")},
+		{"prefixed type field", "type_fields.environment_21000123456", assetFieldPath, path.Root("type_fields").AtMapKey("environment")},
+		{"location address", "address.line1", locationFieldPath, path.Root("line1")},
+		{"custom field", "custom_fields.cost_center", attributeFieldPath(DepartmentResourceModel{}), path.Root("custom_fields").AtMapKey("cost_center")},
+		{"nested field", "role_id", attributeFieldPath(AgentResourceModel{}), path.Empty()},
+		{"suffixed field", "workspace_2", attributeFieldPath(AgentGroupResourceModel{}), path.Empty()},
+		{"dotted field", "name.first", attributeFieldPath(DepartmentResourceModel{}), path.Empty()},
+		{"no mapping", "impact", nil, path.Empty()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &freshclient.APIError{
+				Code:        freshclient.ErrClientValidation,
+				Description: "Validation failed",
+				Errors:      []freshclient.FieldError{{Field: tt.field, Message: "It should be valid", Code: "invalid_value"}},
+			}
+
+			var diags diag.Diagnostics
+			addAPIFieldError(&diags, "Error", err, tt.fieldPath)
+			if len(diags) != 1 {
+				t.Fatalf("addAPIFieldError() diagnostics = %v, want 1", diags)
+			}
+
+			got := path.Empty()
+			if withPath, ok := diags[0].(diag.DiagnosticWithPath); ok {
+				got = withPath.Path()
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("addAPIFieldError() path = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
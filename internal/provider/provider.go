@@ -7,11 +7,14 @@ import (
 	"context"
 	"os"
 	"terraform-provider-fresh/internal/freshclient"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -29,8 +32,10 @@ type FreshProvider struct {
 
 // FreshProviderModel describes the provider data model.
 type FreshProviderModel struct {
-	Address types.String `tfsdk:"address"`
-	ApiKey  types.String `tfsdk:"api_key"`
+	Address      types.String `tfsdk:"address"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	MaxAttempts  types.Int64  `tfsdk:"max_attempts"`
+	MaxRetryWait types.Int64  `tfsdk:"max_retry_wait"`
 }
 
 func (p *FreshProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,6 +55,20 @@ func (p *FreshProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"max_attempts": schema.Int64Attribute{
+				Description: "Maximum number of attempts for a request that is rate limited or fails with a server error, defaults to 5. Server errors and network failures are only retried for idempotent requests",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retry_wait": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait before retrying a request, defaults to 60. A longer `Retry-After` is capped to this value",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -90,11 +109,17 @@ func (p *FreshProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
 	// Example client configuration for data sources and resources
 	client := freshclient.NewClient(apiKey, address)
+
+	if !data.MaxAttempts.IsNull() {
+		client.MaxAttempts = int(data.MaxAttempts.ValueInt64())
+	}
+
+	if !data.MaxRetryWait.IsNull() {
+		client.MaxRetryWait = time.Duration(data.MaxRetryWait.ValueInt64()) * time.Second
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -102,6 +127,16 @@ func (p *FreshProvider) Configure(ctx context.Context, req provider.ConfigureReq
 func (p *FreshProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAssetResource,
+		NewAssetTypeResource,
+		NewAssetRelationshipResource,
+		NewRelationshipTypeResource,
+		NewSoftwareResource,
+		NewDepartmentResource,
+		NewLocationResource,
+		NewAgentGroupResource,
+		NewRequesterGroupResource,
+		NewRequesterGroupMemberResource,
+		NewAgentResource,
 	}
 }
 
@@ -109,6 +144,16 @@ func (p *FreshProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewAssetTypeDataSource,
 		NewAssetDataSource,
+		NewAssetsDataSource,
+		NewAssetTypeFieldsDataSource,
+		NewAssetTypesDataSource,
+		NewRelationshipTypesDataSource,
+		NewAssetComponentsDataSource,
+		NewAssetSoftwareDataSource,
+		NewDepartmentDataSource,
+		NewLocationDataSource,
+		NewAgentGroupDataSource,
+		NewAgentDataSource,
 	}
 }
 
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"terraform-provider-fresh/internal/freshclient"
+	"terraform-provider-fresh/internal/freshtest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"fresh": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccAssetTypeName is an asset type that exists in the test tenant.
+const testAccAssetTypeName = "VMware VCenter VM"
+
+// testAccRelationshipType is the downstream relation of a relationship type
+// that exists in the test tenant.
+const testAccRelationshipType = "Depends on"
+
+// testAccSetup points the provider at the tenant in FRESH_ADDRESS and
+// FRESH_API_KEY when both are set, otherwise at a fake API seeded with the
+// test asset type and relationship type. It returns a client for the same API and the fake server,
+// which is nil when running against a real tenant.
+func testAccSetup(t *testing.T) (*freshclient.Client, *freshtest.Server) {
+	var server *freshtest.Server
+	if os.Getenv("FRESH_ADDRESS") == "" || os.Getenv("FRESH_API_KEY") == "" {
+		server = freshtest.NewServer(t)
+		server.AddAssetType(testAccAssetTypeName, 0)
+		server.AddRelationshipType(testAccRelationshipType, "Used by")
+
+		t.Setenv("FRESH_ADDRESS", server.Endpoint())
+		t.Setenv("FRESH_API_KEY", freshtest.APIKey)
+	}
+
+	client := freshclient.NewClient(os.Getenv("FRESH_API_KEY"), os.Getenv("FRESH_ADDRESS"))
+	if server != nil {
+		client.MaxRetryWait = 10 * time.Millisecond
+		client.JobPollInterval = 10 * time.Millisecond
+	}
+
+	return client, server
+}
+
+// testAccRequireFake skips tests that depend on data only the fake API can seed.
+func testAccRequireFake(t *testing.T, server *freshtest.Server) {
+	if server == nil {
+		t.Skip("Test requires the fake FreshService API, unset FRESH_ADDRESS and FRESH_API_KEY")
+	}
+}
@@ -0,0 +1,525 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure AgentResource satisfies various resource interfaces.
+var _ resource.Resource = &AgentResource{}
+var _ resource.ResourceWithImportState = &AgentResource{}
+
+// NewAgentResource returns a new resource.
+func NewAgentResource() resource.Resource {
+	return &AgentResource{}
+}
+
+// AgentResource defines the resource implementation.
+type AgentResource struct {
+	client *freshclient.Client
+}
+
+// AgentResourceModel describes the resource data model.
+type AgentResourceModel struct {
+	Active             types.Bool       `tfsdk:"active"`
+	CreatedAt          types.String     `tfsdk:"created_at"`
+	DepartmentIDs      []types.Int64    `tfsdk:"department_ids"`
+	Email              types.String     `tfsdk:"email"`
+	FirstName          types.String     `tfsdk:"first_name"`
+	ID                 types.Int64      `tfsdk:"id"`
+	JobTitle           types.String     `tfsdk:"job_title"`
+	LastName           types.String     `tfsdk:"last_name"`
+	LocationID         types.Int64      `tfsdk:"location_id"`
+	MobilePhoneNumber  types.String     `tfsdk:"mobile_phone_number"`
+	Occasional         types.Bool       `tfsdk:"occasional"`
+	ReportingManagerID types.Int64      `tfsdk:"reporting_manager_id"`
+	Roles              []AgentRoleModel `tfsdk:"roles"`
+	UpdatedAt          types.String     `tfsdk:"updated_at"`
+	WorkPhoneNumber    types.String     `tfsdk:"work_phone_number"`
+	// MemberOf and ObserverOf are sets rather than slices as they are unknown
+	// when left to the agent groups.
+	MemberOf   types.Set `tfsdk:"member_of"`
+	ObserverOf types.Set `tfsdk:"observer_of"`
+	// DeletionMode only controls the provider and is not sent to the API.
+	DeletionMode types.String `tfsdk:"deletion_mode"`
+}
+
+// AgentRoleModel describes a role assigned to the agent.
+type AgentRoleModel struct {
+	AssignmentScope types.String  `tfsdk:"assignment_scope"`
+	Groups          []types.Int64 `tfsdk:"groups"`
+	RoleID          types.Int64   `tfsdk:"role_id"`
+	WorkspaceID     types.Int64   `tfsdk:"workspace_id"`
+}
+
+// Deletion modes of fresh_agent.
+const (
+	agentDeletionModeDeactivate = "deactivate"
+	agentDeletionModeForget     = "forget"
+)
+
+// fromFreshAgent converts an agent from the API, the provider only settings
+// are kept from m.
+func (m AgentResourceModel) fromFreshAgent(agent freshclient.AgentDetails) AgentResourceModel {
+	deletionMode := m.DeletionMode
+	if deletionMode.IsNull() || deletionMode.IsUnknown() {
+		deletionMode = types.StringValue(agentDeletionModeDeactivate)
+	}
+
+	roles := make([]AgentRoleModel, 0, len(agent.Roles))
+	for _, role := range agent.Roles {
+		roles = append(roles, AgentRoleModel{}.fromFreshAgentRole(role))
+	}
+
+	return AgentResourceModel{
+		Active:             types.BoolValue(agent.Active),
+		CreatedAt:          types.StringValue(agent.CreatedAt),
+		DepartmentIDs:      int64Values(agent.DepartmentIDs),
+		Email:              types.StringValue(agent.Email),
+		FirstName:          types.StringValue(agent.FirstName),
+		ID:                 types.Int64Value(agent.ID),
+		JobTitle:           types.StringValue(agent.JobTitle),
+		LastName:           types.StringValue(agent.LastName),
+		LocationID:         optionalInt64(agent.LocationID),
+		MobilePhoneNumber:  types.StringValue(agent.MobilePhoneNumber),
+		Occasional:         types.BoolValue(agent.Occasional),
+		ReportingManagerID: optionalInt64(agent.ReportingManagerID),
+		Roles:              roles,
+		UpdatedAt:          types.StringValue(agent.UpdatedAt),
+		WorkPhoneNumber:    types.StringValue(agent.WorkPhoneNumber),
+		MemberOf:           int64SetValue(agent.MemberOf),
+		ObserverOf:         int64SetValue(agent.ObserverOf),
+
+		DeletionMode: deletionMode,
+	}
+}
+
+// toFreshAgent converts the plan, group memberships left to the agent groups
+// are unknown and not sent.
+func (m AgentResourceModel) toFreshAgent() freshclient.AgentDetails {
+	roles := make([]freshclient.AgentRole, 0, len(m.Roles))
+	for _, role := range m.Roles {
+		roles = append(roles, role.toFreshAgentRole())
+	}
+
+	return freshclient.AgentDetails{
+		DepartmentIDs:      int64sFromValues(m.DepartmentIDs),
+		Email:              m.Email.ValueString(),
+		FirstName:          m.FirstName.ValueString(),
+		ID:                 m.ID.ValueInt64(),
+		JobTitle:           m.JobTitle.ValueString(),
+		LastName:           m.LastName.ValueString(),
+		LocationID:         m.LocationID.ValueInt64(),
+		MemberOf:           int64sFromSet(m.MemberOf),
+		MobilePhoneNumber:  m.MobilePhoneNumber.ValueString(),
+		ObserverOf:         int64sFromSet(m.ObserverOf),
+		Occasional:         m.Occasional.ValueBool(),
+		ReportingManagerID: m.ReportingManagerID.ValueInt64(),
+		Roles:              roles,
+		WorkPhoneNumber:    m.WorkPhoneNumber.ValueString(),
+	}
+}
+
+// fromFreshAgentRole converts a role from the API, groups and the workspace
+// are null when the API has none.
+func (m AgentRoleModel) fromFreshAgentRole(role freshclient.AgentRole) AgentRoleModel {
+	var groups []types.Int64
+	if len(role.Groups) > 0 {
+		groups = int64Values(role.Groups)
+	}
+
+	return AgentRoleModel{
+		AssignmentScope: types.StringValue(role.AssignmentScope),
+		Groups:          groups,
+		RoleID:          types.Int64Value(role.RoleID),
+		WorkspaceID:     optionalInt64(role.WorkspaceID),
+	}
+}
+
+func (m AgentRoleModel) toFreshAgentRole() freshclient.AgentRole {
+	return freshclient.AgentRole{
+		AssignmentScope: m.AssignmentScope.ValueString(),
+		Groups:          int64sFromValues(m.Groups),
+		RoleID:          m.RoleID.ValueInt64(),
+		WorkspaceID:     m.WorkspaceID.ValueInt64(),
+	}
+}
+
+// Metadata returns the metadata for the resource.
+func (r *AgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent"
+}
+
+// Schema returns the schema for the resource.
+func (r *AgentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Agent Resource, manages an agent with its roles and agent group memberships",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the agent is active, agents deactivated outside of Terraform are removed from state and reactivated on the next apply",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"department_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the departments of the agent, see the `fresh_department` resource and data source",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				Optional:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the agent, unique regardless of case",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the agent",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the agent",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_title": schema.StringAttribute{
+				MarkdownDescription: "Job title of the agent",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the agent",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"location_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the location of the agent, see the `fresh_location` resource and data source",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"member_of": schema.SetAttribute{
+				MarkdownDescription: "IDs of the agent groups the agent is a member of. Leave unset to manage the members " +
+					"with `fresh_agent_group` instead, once set every other membership is removed",
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"mobile_phone_number": schema.StringAttribute{
+				MarkdownDescription: "Mobile phone number of the agent",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"observer_of": schema.SetAttribute{
+				MarkdownDescription: "IDs of the agent groups the agent observes. Leave unset to manage the observers " +
+					"with `fresh_agent_group` instead, once set every other observership is removed",
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"occasional": schema.BoolAttribute{
+				MarkdownDescription: "Whether the agent is an occasional agent using day passes instead of a fulltime seat, defaults to `false`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"reporting_manager_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user the agent reports to",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"roles": schema.SetNestedAttribute{
+				MarkdownDescription: "Roles of the agent, at least one is required. Accounts with workspaces assign roles per workspace",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"assignment_scope": schema.StringAttribute{
+							MarkdownDescription: "Tickets and assets the role applies to, `entire_helpdesk`, `member_groups`, `specified_groups` or `assigned_items`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									freshclient.AgentScopeEntireHelpdesk,
+									freshclient.AgentScopeMemberGroups,
+									freshclient.AgentScopeSpecifiedGroups,
+									freshclient.AgentScopeAssignedItems,
+								),
+							},
+						},
+						"groups": schema.SetAttribute{
+							MarkdownDescription: "IDs of the agent groups the role applies to, required for `specified_groups`",
+							ElementType:         types.Int64Type,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+							},
+						},
+						"role_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the role",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"workspace_id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the workspace the role is assigned in, leave unset for accounts without workspaces",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+			"work_phone_number": schema.StringAttribute{
+				MarkdownDescription: "Work phone number of the agent",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "What happens to the agent on destroy, `deactivate` keeps the agent and its history (default) " +
+					"and `forget` permanently deletes the agent and its personal data. " +
+					"A deactivated agent with the same email is reactivated on create",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(agentDeletionModeDeactivate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(agentDeletionModeDeactivate, agentDeletionModeForget),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AgentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AgentResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reactivated, err := r.reactivateAgent(ctx, data)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error reactivating agent", err)
+		return
+	}
+
+	var agentDetails *freshclient.AgentDetails
+	if reactivated != nil {
+		// Adopt the reactivated agent and bring it in line with the plan.
+		agent := data.toFreshAgent()
+		agent.ID = reactivated.ID
+		agentDetails, err = r.client.UpdateAgent(ctx, agent)
+	} else {
+		agentDetails, err = r.client.CreateAgent(ctx, data.toFreshAgent())
+	}
+
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating agent", err, attributeFieldPath(AgentResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAgent(*agentDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reactivateAgent reactivates the deactivated agent with the email address
+// of the plan, which keeps the address reserved. It returns nil when there is
+// no such agent.
+func (r *AgentResource) reactivateAgent(ctx context.Context, data AgentResourceModel) (*freshclient.AgentDetails, error) {
+	agents, err := r.client.ListAgents(ctx, freshclient.ListAgentsOptions{Email: data.Email.ValueString(), Inactive: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, agent := range agents {
+		if agent.Active || !strings.EqualFold(agent.Email, data.Email.ValueString()) {
+			continue
+		}
+
+		tflog.Info(ctx, "Reactivating deactivated agent", map[string]interface{}{
+			"id":    agent.ID,
+			"email": agent.Email,
+		})
+		return r.client.ReactivateAgent(ctx, agent.ID)
+	}
+
+	return nil, nil
+}
+
+// Read the resource and convert it into a resource object.
+func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentDetails, err := r.client.GetAgent(ctx, data.ID.ValueInt64())
+
+	// The agent was forgotten outside of Terraform, drop it from state so it
+	// gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Agent not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting agent", err)
+		return
+	}
+
+	// The agent was deactivated outside of Terraform, drop it from state so
+	// it gets reactivated on create.
+	if !agentDetails.Active {
+		tflog.Warn(ctx, "Agent is deactivated, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAgent(*agentDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AgentResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentDetails, err := r.client.UpdateAgent(ctx, data.toFreshAgent())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating agent", err, attributeFieldPath(AgentResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAgent(*agentDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AgentResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if data.DeletionMode.ValueString() == agentDeletionModeForget {
+		err = r.client.ForgetAgent(ctx, data.ID.ValueInt64())
+	} else {
+		err = r.client.DeactivateAgent(ctx, data.ID.ValueInt64())
+	}
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting agent", err)
+		return
+	}
+}
+
+// ImportState imports an agent by its ID.
+func (r *AgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the agent, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure AgentGroupResource satisfies various resource interfaces.
+var _ resource.Resource = &AgentGroupResource{}
+var _ resource.ResourceWithImportState = &AgentGroupResource{}
+
+// NewAgentGroupResource returns a new resource.
+func NewAgentGroupResource() resource.Resource {
+	return &AgentGroupResource{}
+}
+
+// AgentGroupResource defines the resource implementation.
+type AgentGroupResource struct {
+	client *freshclient.Client
+}
+
+// AgentGroupResourceModel describes the resource data model, Members and
+// Observers are sets rather than slices as they are unknown when left to the
+// agents.
+type AgentGroupResourceModel struct {
+	BusinessHoursID types.Int64   `tfsdk:"business_hours_id"`
+	CreatedAt       types.String  `tfsdk:"created_at"`
+	Description     types.String  `tfsdk:"description"`
+	EscalateTo      types.Int64   `tfsdk:"escalate_to"`
+	ID              types.Int64   `tfsdk:"id"`
+	Leaders         []types.Int64 `tfsdk:"leaders"`
+	Members         types.Set     `tfsdk:"members"`
+	Name            types.String  `tfsdk:"name"`
+	Observers       types.Set     `tfsdk:"observers"`
+	UnassignedFor   types.String  `tfsdk:"unassigned_for"`
+	UpdatedAt       types.String  `tfsdk:"updated_at"`
+}
+
+func (m AgentGroupResourceModel) fromFreshAgentGroup(agentGroup freshclient.AgentGroupDetails) AgentGroupResourceModel {
+	return AgentGroupResourceModel{
+		BusinessHoursID: optionalInt64(agentGroup.BusinessHoursID),
+		CreatedAt:       types.StringValue(agentGroup.CreatedAt),
+		Description:     types.StringValue(agentGroup.Description),
+		EscalateTo:      optionalInt64(agentGroup.EscalateTo),
+		ID:              types.Int64Value(agentGroup.ID),
+		Leaders:         int64Values(agentGroup.Leaders),
+		Members:         int64SetValue(agentGroup.Members),
+		Name:            types.StringValue(agentGroup.Name),
+		Observers:       int64SetValue(agentGroup.Observers),
+		UnassignedFor:   optionalString(agentGroup.UnassignedFor),
+		UpdatedAt:       types.StringValue(agentGroup.UpdatedAt),
+	}
+}
+
+func (m AgentGroupResourceModel) toFreshAgentGroup() freshclient.AgentGroupDetails {
+	return freshclient.AgentGroupDetails{
+		BusinessHoursID: m.BusinessHoursID.ValueInt64(),
+		Description:     m.Description.ValueString(),
+		EscalateTo:      m.EscalateTo.ValueInt64(),
+		ID:              m.ID.ValueInt64(),
+		Leaders:         int64sFromValues(m.Leaders),
+		Members:         int64sFromSet(m.Members),
+		Name:            m.Name.ValueString(),
+		Observers:       int64sFromSet(m.Observers),
+		UnassignedFor:   m.UnassignedFor.ValueString(),
+	}
+}
+
+// int64Values converts a list of IDs from the API, nil becomes an empty list.
+func int64Values(ids []int64) []types.Int64 {
+	values := make([]types.Int64, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, types.Int64Value(id))
+	}
+
+	return values
+}
+
+// int64sFromValues converts a list of IDs from Terraform.
+func int64sFromValues(values []types.Int64) []int64 {
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, value.ValueInt64())
+	}
+
+	return ids
+}
+
+// int64SetValue converts a set of IDs from the API, nil becomes an empty set.
+func int64SetValue(ids []int64) types.Set {
+	values := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, types.Int64Value(id))
+	}
+
+	return types.SetValueMust(types.Int64Type, values)
+}
+
+// int64sFromSet converts a set of IDs from Terraform, an unknown or null set
+// becomes nil.
+func int64sFromSet(set types.Set) []int64 {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(set.Elements()))
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.Int64); ok {
+			ids = append(ids, value.ValueInt64())
+		}
+	}
+
+	return ids
+}
+
+// Metadata returns the metadata for the resource.
+func (r *AgentGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_group"
+}
+
+// Schema returns the schema for the resource.
+func (r *AgentGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Agent Group Resource, manages an agent group and its members",
+
+		Attributes: map[string]schema.Attribute{
+			"business_hours_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the business hours of the group, leave unset for the default business hours",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"escalate_to": schema.Int64Attribute{
+				MarkdownDescription: "ID of the agent tickets are escalated to when they stay unassigned for `unassigned_for`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("unassigned_for")),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the group",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"leaders": schema.SetAttribute{
+				MarkdownDescription: "IDs of the agents leading the group",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				Optional:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "IDs of the agents in the group. Leave unset to manage the members with `member_of` of `fresh_agent` instead, " +
+					"once set the list is authoritative and agents added outside of Terraform are removed",
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group, unique regardless of case",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"observers": schema.SetAttribute{
+				MarkdownDescription: "IDs of the agents observing the tickets of the group without being members. " +
+					"Leave unset to manage the observers with `observer_of` of `fresh_agent` instead",
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"unassigned_for": schema.StringAttribute{
+				MarkdownDescription: "Time after which unassigned tickets are escalated to `escalate_to`, " +
+					"one of `30m`, `1h`, `2h`, `4h`, `8h`, `12h`, `1d`, `2d` or `3d`",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("30m", "1h", "2h", "4h", "8h", "12h", "1d", "2d", "3d"),
+					stringvalidator.AlsoRequires(path.MatchRoot("escalate_to")),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AgentGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *AgentGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AgentGroupResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentGroupDetails, err := r.client.CreateAgentGroup(ctx, data.toFreshAgentGroup())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating agent group", err, attributeFieldPath(AgentGroupResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAgentGroup(*agentGroupDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *AgentGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AgentGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentGroupDetails, err := r.client.GetAgentGroup(ctx, data.ID.ValueInt64())
+
+	// The agent group was deleted outside of Terraform, drop it from state so
+	// it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Agent group not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting agent group", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAgentGroup(*agentGroupDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *AgentGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AgentGroupResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentGroupDetails, err := r.client.UpdateAgentGroup(ctx, data.toFreshAgentGroup())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating agent group", err, attributeFieldPath(AgentGroupResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAgentGroup(*agentGroupDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *AgentGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AgentGroupResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAgentGroup(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting agent group", err)
+		return
+	}
+}
+
+// ImportState imports an agent group by its ID.
+func (r *AgentGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the agent group, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAgentGroupResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentGroupDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name        = "TestAcc Service Desk"
+  description = "First line support"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "name", "TestAcc Service Desk"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "description", "First line support"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "members.#", "0"),
+					resource.TestCheckNoResourceAttr("fresh_agent_group.test", "escalate_to"),
+					resource.TestCheckNoResourceAttr("fresh_agent_group.test", "unassigned_for"),
+					resource.TestCheckResourceAttrWith("fresh_agent_group.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_agent_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing, unset arguments fall back to their defaults
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name = "TestAcc Second Line"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_agent_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "name", "TestAcc Second Line"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgentGroupResourceMembership(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentGroupDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name           = "TestAcc Network"
+  members        = [101, 102]
+  leaders        = [101]
+  observers      = [103]
+  escalate_to    = 101
+  unassigned_for = "30m"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemAttr("fresh_agent_group.test", "members.*", "102"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "leaders.#", "1"),
+					resource.TestCheckTypeSetElemAttr("fresh_agent_group.test", "observers.*", "103"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "escalate_to", "101"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "unassigned_for", "30m"),
+					resource.TestCheckResourceAttrWith("fresh_agent_group.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// Members changed outside of Terraform are put back
+			{
+				PreConfig: func() {
+					groupID, _ := strconv.ParseInt(id, 10, 64)
+					group, err := client.GetAgentGroup(context.Background(), groupID)
+					if err != nil {
+						t.Fatalf("freshclient.GetAgentGroup() error = %v", err)
+					}
+					group.Members = append(group.Members, 104)
+					if _, err := client.UpdateAgentGroup(context.Background(), *group); err != nil {
+						t.Fatalf("freshclient.UpdateAgentGroup() error = %v", err)
+					}
+				},
+				Config: `
+resource "fresh_agent_group" "test" {
+  name           = "TestAcc Network"
+  members        = [101, 102]
+  leaders        = [101]
+  observers      = [103]
+  escalate_to    = 101
+  unassigned_for = "30m"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_agent_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("fresh_agent_group.test", "members.#", "2"),
+			},
+			// Removing members, observers and the escalation
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name      = "TestAcc Network"
+  members   = [102]
+  observers = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("fresh_agent_group.test", "members.*", "102"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "leaders.#", "0"),
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "observers.#", "0"),
+					resource.TestCheckNoResourceAttr("fresh_agent_group.test", "escalate_to"),
+					resource.TestCheckNoResourceAttr("fresh_agent_group.test", "unassigned_for"),
+				),
+			},
+			// Members left unset are no longer managed
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name = "TestAcc Network"
+}
+`,
+				Check: resource.TestCheckResourceAttr("fresh_agent_group.test", "members.#", "1"),
+			},
+		},
+	})
+}
+
+// testAccCheckAgentGroupDestroyed checks that the agent group is gone from the API.
+func testAccCheckAgentGroupDestroyed(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		groupID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetAgentGroup(context.Background(), groupID)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("agent group %d still exists: %v", groupID, err)
+	}
+}
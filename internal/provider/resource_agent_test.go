@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAgentResource(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDeactivated(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name = "TestAcc Agent Desk"
+}
+
+resource "fresh_agent" "test" {
+  email      = "testacc.agent@example.com"
+  first_name = "Alex"
+  job_title  = "IT Engineer"
+  member_of  = [fresh_agent_group.test.id]
+
+  roles = [
+    {
+      role_id          = 1
+      assignment_scope = "member_groups"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent.test", "email", "testacc.agent@example.com"),
+					resource.TestCheckResourceAttr("fresh_agent.test", "job_title", "IT Engineer"),
+					resource.TestCheckResourceAttr("fresh_agent.test", "last_name", ""),
+					resource.TestCheckResourceAttr("fresh_agent.test", "active", "true"),
+					resource.TestCheckResourceAttr("fresh_agent.test", "occasional", "false"),
+					resource.TestCheckResourceAttr("fresh_agent.test", "deletion_mode", "deactivate"),
+					resource.TestCheckTypeSetElemAttrPair("fresh_agent.test", "member_of.*", "fresh_agent_group.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("fresh_agent.test", "roles.*", map[string]string{
+						"role_id":          "1",
+						"assignment_scope": "member_groups",
+					}),
+					resource.TestCheckResourceAttrWith("fresh_agent.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_agent.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing, memberships left unset are kept
+			{
+				Config: `
+resource "fresh_agent_group" "test" {
+  name = "TestAcc Agent Desk"
+}
+
+resource "fresh_agent" "test" {
+  email      = "testacc.agent@example.com"
+  first_name = "Alex"
+  job_title  = "IT Lead"
+
+  roles = [
+    {
+      role_id          = 1
+      assignment_scope = "member_groups"
+    },
+    {
+      role_id          = 2
+      assignment_scope = "specified_groups"
+      groups           = [fresh_agent_group.test.id]
+      workspace_id     = 2
+    },
+  ]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_agent.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent.test", "job_title", "IT Lead"),
+					resource.TestCheckResourceAttr("fresh_agent.test", "member_of.#", "1"),
+					resource.TestCheckResourceAttr("fresh_agent.test", "roles.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("fresh_agent.test", "roles.*", map[string]string{
+						"role_id":          "2",
+						"assignment_scope": "specified_groups",
+						"groups.#":         "1",
+						"workspace_id":     "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgentResourceGroupMembers(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentForgotten(client, &id),
+		Steps: []resource.TestStep{
+			// Memberships managed by the agent group do not cause a diff on the agent
+			{
+				Config: `
+resource "fresh_agent" "test" {
+  email         = "testacc.member@example.com"
+  first_name    = "Sam"
+  deletion_mode = "forget"
+
+  roles = [
+    {
+      role_id          = 1
+      assignment_scope = "entire_helpdesk"
+    },
+  ]
+}
+
+resource "fresh_agent_group" "test" {
+  name    = "TestAcc Member Desk"
+  members = [fresh_agent.test.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_agent_group.test", "members.#", "1"),
+					resource.TestCheckResourceAttrWith("fresh_agent.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// Refreshing picks up the membership without changes
+			{
+				RefreshState: true,
+				Check:        resource.TestCheckTypeSetElemAttrPair("fresh_agent.test", "member_of.*", "fresh_agent_group.test", "id"),
+			},
+		},
+	})
+}
+
+// TestAccAgentResourceReactivate tests that deactivated agents are
+// reactivated instead of failing on their reserved email address.
+func TestAccAgentResourceReactivate(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	var id string
+
+	checkSameAgent := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("fresh_agent.test", "active", "true"),
+		resource.TestCheckResourceAttrWith("fresh_agent.test", "id", func(value string) error {
+			if value != id {
+				return fmt.Errorf("id = %s, want the reactivated agent %s", value, id)
+			}
+			return nil
+		}),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDeactivated(client, &id),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentResourceReactivateConfig("IT Engineer"),
+				Check: resource.TestCheckResourceAttrWith("fresh_agent.test", "id", func(value string) error {
+					id = value
+					return nil
+				}),
+			},
+			// Agents deactivated outside of Terraform are planned for creation
+			// and reactivated
+			{
+				PreConfig: func() {
+					agentID, _ := strconv.ParseInt(id, 10, 64)
+					if err := client.DeactivateAgent(context.Background(), agentID); err != nil {
+						t.Fatalf("freshclient.DeactivateAgent() error = %v", err)
+					}
+				},
+				Config: testAccAgentResourceReactivateConfig("IT Lead"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_agent.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkSameAgent,
+					resource.TestCheckResourceAttr("fresh_agent.test", "job_title", "IT Lead"),
+				),
+			},
+			// Destroy deactivates the agent
+			{
+				Config: `# no agent`,
+				Check:  testAccCheckAgentDeactivated(client, &id),
+			},
+			// Applying again reactivates the agent
+			{
+				Config: testAccAgentResourceReactivateConfig("IT Lead"),
+				Check:  checkSameAgent,
+			},
+		},
+	})
+}
+
+func testAccAgentResourceReactivateConfig(jobTitle string) string {
+	return fmt.Sprintf(`
+resource "fresh_agent" "test" {
+  email      = "testacc.reactivate@example.com"
+  first_name = "Robin"
+  job_title  = %q
+
+  roles = [
+    {
+      role_id          = 1
+      assignment_scope = "member_groups"
+    },
+  ]
+}
+`, jobTitle)
+}
+
+// testAccCheckAgentDeactivated checks that the agent was deactivated.
+func testAccCheckAgentDeactivated(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		agentID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		agent, err := client.GetAgent(context.Background(), agentID)
+		if err != nil {
+			return err
+		}
+		if agent.Active {
+			return fmt.Errorf("agent %d is still active", agentID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAgentForgotten checks that the agent is gone from the API.
+func testAccCheckAgentForgotten(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		agentID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetAgent(context.Background(), agentID)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("agent %d still exists: %v", agentID, err)
+	}
+}
@@ -2,21 +2,34 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
-	"log"
+	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"terraform-provider-fresh/internal/freshclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// datePattern and dateTimePattern match the date formats returned by the API.
+var (
+	datePattern     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	dateTimePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)
+)
+
 // Ensure AssetResource satisfies various resource interfaces.
 var _ resource.Resource = &AssetResource{}
 var _ resource.ResourceWithImportState = &AssetResource{}
@@ -50,9 +63,31 @@ type AssetResourceModel struct {
 	UpdatedAt    types.String `tfsdk:"updated_at"`
 	UsageType    types.String `tfsdk:"usage_type"`
 	UserID       types.Int64  `tfsdk:"user_id"`
+	TypeFields   types.Map    `tfsdk:"type_fields"`
+	// DeletionMode and RestoreFromTrash only control the provider and are
+	// not sent to the API.
+	DeletionMode     types.String `tfsdk:"deletion_mode"`
+	RestoreFromTrash types.Bool   `tfsdk:"restore_from_trash"`
 }
 
+// Deletion modes of fresh_asset.
+const (
+	assetDeletionModeTrash     = "trash"
+	assetDeletionModePermanent = "permanent"
+)
+
+// fromFreshAsset converts an asset from the API, type fields are limited to
+// the ones managed in m and the provider only settings are kept from m.
 func (m AssetResourceModel) fromFreshAsset(assetDetails freshclient.AssetDetails) AssetResourceModel {
+	deletionMode := m.DeletionMode
+	if deletionMode.IsNull() || deletionMode.IsUnknown() {
+		deletionMode = types.StringValue(assetDeletionModeTrash)
+	}
+	restoreFromTrash := m.RestoreFromTrash
+	if restoreFromTrash.IsNull() || restoreFromTrash.IsUnknown() {
+		restoreFromTrash = types.BoolValue(false)
+	}
+
 	return AssetResourceModel{
 		Name:         types.StringValue(assetDetails.Name),
 		AssetTag:     types.StringValue(assetDetails.AssetTag),
@@ -71,12 +106,15 @@ func (m AssetResourceModel) fromFreshAsset(assetDetails freshclient.AssetDetails
 		UpdatedAt:    types.StringValue(assetDetails.UpdatedAt),
 		UsageType:    types.StringValue(assetDetails.UsageType),
 		UserID:       types.Int64Value(assetDetails.UserID),
+		TypeFields:   managedTypeFieldsValue(assetDetails.TypeFields, m.TypeFields),
+
+		DeletionMode:     deletionMode,
+		RestoreFromTrash: restoreFromTrash,
 	}
 }
 
 func (m AssetResourceModel) toFreshAsset() freshclient.AssetDetails {
 	return freshclient.AssetDetails{
-		AgentID:      m.AssetTypeID.ValueInt64(), // Set to your actual value or leave it as 0 based on your logic
 		AssetTag:     m.AssetTag.ValueString(),
 		AssetTypeID:  m.AssetTypeID.ValueInt64(),
 		AssignedOn:   m.AssignedOn.ValueString(),
@@ -94,7 +132,19 @@ func (m AssetResourceModel) toFreshAsset() freshclient.AssetDetails {
 		UpdatedAt:    m.UpdatedAt.ValueString(),
 		UsageType:    m.UsageType.ValueString(),
 		UserID:       m.UserID.ValueInt64(),
+		TypeFields:   typeFieldsFromValue(m.TypeFields),
+	}
+}
+
+// assetFieldPath returns the attribute path of a field reported by the API,
+// type fields carry an asset type ID suffix and live in the type_fields map.
+func assetFieldPath(field string) (path.Path, bool) {
+	typeField := strings.TrimPrefix(field, "type_fields.")
+	if typeField != field || freshclient.TypeFieldName(field) != field {
+		return path.Root("type_fields").AtMapKey(freshclient.TypeFieldName(typeField)), true
 	}
+
+	return attributeFieldPath(AssetResourceModel{})(field)
 }
 
 // Metadata returns the metadata for the resource.
@@ -119,6 +169,7 @@ func (r *AssetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"asset_tag": schema.StringAttribute{
 				MarkdownDescription: "Asset tag of the asset type",
 				Computed:            true,
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -133,6 +184,10 @@ func (r *AssetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"assigned_on": schema.StringAttribute{
 				MarkdownDescription: "Date and time of assignment",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dateTimePattern, "must be a date and time like 2023-11-24T10:00:00Z"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -152,8 +207,12 @@ func (r *AssetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"department_id": schema.Int64Attribute{
-				MarkdownDescription: "ID of the department",
+				MarkdownDescription: "ID of the department, see the `fresh_department` resource and data source",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -176,13 +235,21 @@ func (r *AssetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"end_of_life": schema.StringAttribute{
 				MarkdownDescription: "Date and time of end of life",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(datePattern, "must be a date like 2023-11-24"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"group_id": schema.Int64Attribute{
-				MarkdownDescription: "ID of the group",
+				MarkdownDescription: "ID of the agent group managing the asset, see `fresh_agent_group`",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -195,15 +262,23 @@ func (r *AssetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"impact": schema.StringAttribute{
-				MarkdownDescription: "Impact of the asset type",
+				MarkdownDescription: "Impact of the asset, one of `low`, `medium` or `high`",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("low", "medium", "high"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"location_id": schema.Int64Attribute{
-				MarkdownDescription: "ID of the location",
+				MarkdownDescription: "ID of the location, see the `fresh_location` resource and data source",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -213,19 +288,49 @@ func (r *AssetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 			"usage_type": schema.StringAttribute{
-				MarkdownDescription: "Usage type of the asset type",
+				MarkdownDescription: "Usage type of the asset, either `permanent` or `loaner`",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("permanent", "loaner"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"user_id": schema.Int64Attribute{
-				MarkdownDescription: "ID of the user",
+				MarkdownDescription: "ID of the user the asset is assigned to, agents can be looked up by email with the `fresh_agent` data source",
 				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"type_fields": schema.MapAttribute{
+				MarkdownDescription: "Custom fields of the asset type keyed by field name, e.g. `serial_number`. " +
+					"The asset type ID suffix FreshService adds to field names is optional. Only the fields set here are managed. " +
+					"Values are converted to the data type of the field, e.g. `\"1200\"` for a number and `\"true\"` for a checkbox, an empty value clears the field",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "What happens to the asset on destroy, `trash` moves it to the trash (default) and `permanent` deletes it forever",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(assetDeletionModeTrash),
+				Validators: []validator.String{
+					stringvalidator.OneOf(assetDeletionModeTrash, assetDeletionModePermanent),
+				},
+			},
+			"restore_from_trash": schema.BoolAttribute{
+				MarkdownDescription: "Restore a trashed asset with the same name and asset type on create instead of creating a new one, defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -260,20 +365,33 @@ func (r *AssetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	newAssetDetail := freshclient.AssetDetails{
-		Name:        data.Name.ValueString(),
-		AssetTypeID: data.AssetTypeID.ValueInt64(),
-		Description: data.Description.ValueString(),
-	}
+	// Unknown computed values are left out of the request.
+	newAssetDetail := data.toFreshAsset()
 
 	// Create the resource.
 	var assetDetails *freshclient.AssetDetails
 	if r.client != nil {
-		var err error
-		assetDetails, err = r.client.CreateAsset(newAssetDetail)
+		newAssetDetail.TypeFields = r.typeFieldsRequest(ctx, &resp.Diagnostics, data.AssetTypeID.ValueInt64(), typeFieldsFromValue(data.TypeFields))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		restored, err := r.restoreTrashedAsset(ctx, data)
+		if err != nil {
+			addAPIFieldError(&resp.Diagnostics, "Error restoring asset", err, assetFieldPath)
+			return
+		}
+
+		if restored != nil {
+			// Adopt the restored asset and bring it in line with the plan.
+			newAssetDetail.DisplayID = restored.DisplayID
+			assetDetails, err = r.client.UpdateAsset(ctx, newAssetDetail)
+		} else {
+			assetDetails, err = r.client.CreateAsset(ctx, newAssetDetail)
+		}
 
 		if err != nil {
-			resp.Diagnostics.AddError("Error creating asset", err.Error())
+			addAPIFieldError(&resp.Diagnostics, "Error creating asset", err, assetFieldPath)
 			return
 		}
 	} else {
@@ -288,9 +406,64 @@ func (r *AssetResource) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// typeFieldsRequest returns the type fields to send for an asset type. Keys
+// are taken from the fields of the asset type so inherited fields get the
+// suffix of the parent asset type defining them.
+func (r *AssetResource) typeFieldsRequest(ctx context.Context, diags *diag.Diagnostics, assetTypeID int64, typeFields map[string]interface{}) map[string]interface{} {
+	if len(typeFields) == 0 {
+		return typeFields
+	}
+
+	fields, err := r.client.GetAssetTypeFields(ctx, assetTypeID)
+	if err != nil {
+		addAPIError(diags, "Error getting asset type fields", err)
+		return nil
+	}
+
+	return typeFieldsRequest(diags, typeFields, assetTypeID, fields)
+}
+
+// restoreTrashedAsset restores the trashed asset matching the name and asset
+// type of the plan when restore_from_trash is set. It returns nil when there
+// is nothing to restore.
+func (r *AssetResource) restoreTrashedAsset(ctx context.Context, data AssetResourceModel) (*freshclient.AssetDetails, error) {
+	if !data.RestoreFromTrash.ValueBool() {
+		return nil, nil
+	}
+
+	trashed, err := r.client.ListTrashedAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []freshclient.AssetDetails
+	for _, asset := range trashed {
+		if asset.Name == data.Name.ValueString() && asset.AssetTypeID == data.AssetTypeID.ValueInt64() {
+			matches = append(matches, asset)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("found %d trashed assets named %q, restore one of them in FreshService or disable restore_from_trash", len(matches), data.Name.ValueString())
+	}
+
+	tflog.Info(ctx, "Restoring trashed asset", map[string]interface{}{
+		"display_id": matches[0].DisplayID,
+	})
+	if err := r.client.RestoreAsset(ctx, matches[0]); err != nil {
+		return nil, err
+	}
+
+	return r.client.GetAsset(ctx, matches[0].DisplayID)
+}
+
 // Read the resource and convert it into a resource object.
 func (d *AssetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data AssetDataSourceModel
+	var data AssetResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -298,10 +471,20 @@ func (d *AssetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	tflog.Info(ctx, strconv.FormatInt(data.DisplayID.ValueInt64(), 10))
-	assetDetails, err := d.client.GetAsset(data.DisplayID.ValueInt64())
+	assetDetails, err := d.client.GetAsset(ctx, data.DisplayID.ValueInt64())
+
+	// The asset was deleted or trashed outside of Terraform, drop it from
+	// state so it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Asset not found, removing it from state", map[string]interface{}{
+			"display_id": data.DisplayID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error getting asset", err.Error())
+		addAPIError(&resp.Diagnostics, "Error getting asset", err)
 		return
 	}
 
@@ -312,24 +495,30 @@ func (d *AssetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update the resource.
 func (r *AssetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data AssetResourceModel
+	var data, state AssetResourceModel
 
 	// Read the resource data from Terraform into the data model.
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data_byes, _ := json.Marshal(data)
-	log.Println(string(data_byes))
-	fresh_byes, _ := json.Marshal(data.toFreshAsset())
-	log.Println(string(fresh_byes))
 
-	// Create the resource.
-	assetDetails, err := r.client.UpdateAsset(data.toFreshAsset())
-	log.Println("DOINGUPDATE")
-	log.Println(json.Marshal(assetDetails))
+	assetDetail := data.toFreshAsset()
+	assetDetail.TypeFields = r.typeFieldsRequest(ctx, &resp.Diagnostics, data.AssetTypeID.ValueInt64(), updatedFieldsFromValue(data.TypeFields, state.TypeFields))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating asset", map[string]interface{}{
+		"display_id":  assetDetail.DisplayID,
+		"type_fields": len(assetDetail.TypeFields),
+	})
+
+	// Update the resource.
+	assetDetails, err := r.client.UpdateAsset(ctx, assetDetail)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating asset", err.Error())
+		addAPIFieldError(&resp.Diagnostics, "Error updating asset", err, assetFieldPath)
 		return
 	}
 
@@ -351,17 +540,34 @@ func (r *AssetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Create the resource.
-	err := r.client.DeleteAsset(data.toFreshAsset())
+	err := r.client.DeleteAsset(ctx, data.toFreshAsset())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error deleting asset", err.Error())
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting asset", err)
 		return
 	}
 
+	// Purge the asset from the trash, also when it was trashed outside of Terraform.
+	if data.DeletionMode.ValueString() == assetDeletionModePermanent {
+		err := r.client.DeleteAssetForever(ctx, data.toFreshAsset())
+		if err != nil && !freshclient.IsNotFound(err) {
+			addAPIError(&resp.Diagnostics, "Error permanently deleting asset", err)
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ImportState imports an asset by its display ID.
 func (r *AssetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("display_id"), req, resp)
+	displayID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the display ID of the asset, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("display_id"), displayID)...)
 }
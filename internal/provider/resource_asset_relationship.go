@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure AssetRelationshipResource satisfies various resource interfaces.
+var _ resource.Resource = &AssetRelationshipResource{}
+var _ resource.ResourceWithImportState = &AssetRelationshipResource{}
+
+// NewAssetRelationshipResource returns a new resource.
+func NewAssetRelationshipResource() resource.Resource {
+	return &AssetRelationshipResource{}
+}
+
+// AssetRelationshipResource defines the resource implementation.
+type AssetRelationshipResource struct {
+	client *freshclient.Client
+}
+
+// AssetRelationshipResourceModel describes the resource data model.
+type AssetRelationshipResourceModel struct {
+	CreatedAt          types.String `tfsdk:"created_at"`
+	ID                 types.Int64  `tfsdk:"id"`
+	PrimaryID          types.Int64  `tfsdk:"primary_id"`
+	RelationshipTypeID types.Int64  `tfsdk:"relationship_type_id"`
+	SecondaryID        types.Int64  `tfsdk:"secondary_id"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+func (m AssetRelationshipResourceModel) fromFreshRelationship(relationship freshclient.RelationshipDetails) AssetRelationshipResourceModel {
+	return AssetRelationshipResourceModel{
+		CreatedAt:          types.StringValue(relationship.CreatedAt),
+		ID:                 types.Int64Value(relationship.ID),
+		PrimaryID:          types.Int64Value(relationship.PrimaryID),
+		RelationshipTypeID: types.Int64Value(relationship.RelationshipTypeID),
+		SecondaryID:        types.Int64Value(relationship.SecondaryID),
+		UpdatedAt:          types.StringValue(relationship.UpdatedAt),
+	}
+}
+
+func (m AssetRelationshipResourceModel) toFreshRelationship() freshclient.RelationshipDetails {
+	return freshclient.RelationshipDetails{
+		ID:                 m.ID.ValueInt64(),
+		PrimaryID:          m.PrimaryID.ValueInt64(),
+		PrimaryType:        freshclient.RelationshipEntityAsset,
+		RelationshipTypeID: m.RelationshipTypeID.ValueInt64(),
+		SecondaryID:        m.SecondaryID.ValueInt64(),
+		SecondaryType:      freshclient.RelationshipEntityAsset,
+	}
+}
+
+// Metadata returns the metadata for the resource.
+func (r *AssetRelationshipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_relationship"
+}
+
+// Schema returns the schema for the resource.
+func (r *AssetRelationshipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Asset Relationship Resource, relates two assets with a relationship type. " +
+			"Relationships cannot be changed, any change replaces the relationship",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the relationship",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_id": schema.Int64Attribute{
+				MarkdownDescription: "Display ID of the primary asset, the downstream relation of the relationship type reads from it, e.g. VM `Runs On` host",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"relationship_type_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the relationship type, see the `fresh_relationship_types` data source",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"secondary_id": schema.Int64Attribute{
+				MarkdownDescription: "Display ID of the secondary asset",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AssetRelationshipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *AssetRelationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AssetRelationshipResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateRelationships(ctx, []freshclient.RelationshipDetails{data.toFreshRelationship()})
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating asset relationship", err)
+		return
+	}
+	if len(created) != 1 {
+		resp.Diagnostics.AddError("Error creating asset relationship", fmt.Sprintf("expected 1 relationship to be created, got %d", len(created)))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRelationship(created[0])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *AssetRelationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AssetRelationshipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationship, err := r.client.GetRelationship(ctx, data.ID.ValueInt64())
+
+	// The relationship or one of its assets was deleted outside of Terraform,
+	// drop it from state so it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Asset relationship not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting asset relationship", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRelationship(*relationship)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource, every argument requires a replacement so there is
+// nothing to send to the API.
+func (r *AssetRelationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssetRelationshipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *AssetRelationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AssetRelationshipResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRelationships(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting asset relationship", err)
+		return
+	}
+}
+
+// ImportState imports an asset relationship by its ID.
+func (r *AssetRelationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the relationship, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
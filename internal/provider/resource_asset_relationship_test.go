@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssetRelationshipResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var ids []string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAssetRelationshipsDestroyed(client, &ids),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssetRelationshipResourceConfig("vm"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fresh_asset_relationship.test", "primary_id", "fresh_asset.service", "display_id"),
+					resource.TestCheckResourceAttrPair("fresh_asset_relationship.test", "secondary_id", "fresh_asset.vm", "display_id"),
+					resource.TestCheckResourceAttrSet("fresh_asset_relationship.test", "relationship_type_id"),
+					resource.TestCheckResourceAttrWith("fresh_asset_relationship.test", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_asset_relationship.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing an end replaces the relationship
+			{
+				Config: testAccAssetRelationshipResourceConfig("host"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset_relationship.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fresh_asset_relationship.test", "secondary_id", "fresh_asset.host", "display_id"),
+					resource.TestCheckResourceAttrWith("fresh_asset_relationship.test", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+				),
+			},
+			// Out of band deletion testing
+			{
+				PreConfig: func() {
+					id, _ := strconv.ParseInt(ids[len(ids)-1], 10, 64)
+					if err := client.DeleteRelationships(context.Background(), id); err != nil {
+						t.Fatalf("freshclient.DeleteRelationships() error = %v", err)
+					}
+				},
+				Config: testAccAssetRelationshipResourceConfig("host"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset_relationship.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith("fresh_asset_relationship.test", "id", func(value string) error {
+					ids = append(ids, value)
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+// testAccCheckAssetRelationshipsDestroyed checks that the relationships are gone from the API.
+func testAccCheckAssetRelationshipsDestroyed(client *freshclient.Client, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, value := range *ids {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.GetRelationship(context.Background(), id)
+			if !freshclient.IsNotFound(err) {
+				return fmt.Errorf("relationship %d still exists: %v", id, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetRelationshipResourceConfig(secondary string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+data "fresh_relationship_types" "all" {}
+
+locals {
+  relationship_type_id = one([for relationship_type in data.fresh_relationship_types.all.relationship_types : relationship_type.id if relationship_type.downstream_relation == %[2]q])
+}
+
+resource "fresh_asset" "service" {
+  name          = "TestAccRelationshipService"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset" "vm" {
+  name          = "TestAccRelationshipVM"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset" "host" {
+  name          = "TestAccRelationshipHost"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset_relationship" "test" {
+  primary_id           = fresh_asset.service.display_id
+  secondary_id         = fresh_asset.%[3]s.display_id
+  relationship_type_id = local.relationship_type_id
+}
+`, testAccAssetTypeName, testAccRelationshipType, secondary)
+}
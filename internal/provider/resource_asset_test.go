@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssetResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var displayID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroyed(client, &displayID),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssetResourceConfig("TestAccAsset", "Created by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "name", "TestAccAsset"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttrPair("fresh_asset.test", "asset_type_id", "data.fresh_asset_type.test", "id"),
+					resource.TestCheckResourceAttrWith("fresh_asset.test", "display_id", func(value string) error {
+						displayID = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "fresh_asset.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "display_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return displayID, nil
+				},
+			},
+			// Update in place testing
+			{
+				Config: testAccAssetResourceConfig("TestAccAsset", "Updated by Terraform"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "description", "Updated by Terraform"),
+					resource.TestCheckResourceAttrWith("fresh_asset.test", "display_id", func(value string) error {
+						if value != displayID {
+							return fmt.Errorf("display_id changed from %s to %s", displayID, value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Drift testing, the asset is renamed outside of Terraform
+			{
+				PreConfig: func() {
+					id, _ := strconv.ParseInt(displayID, 10, 64)
+					asset, err := client.GetAsset(context.Background(), id)
+					if err != nil {
+						t.Fatalf("freshclient.GetAsset() error = %v", err)
+					}
+					asset.Name = "TestAccAssetDrifted"
+					if _, err := client.UpdateAsset(context.Background(), *asset); err != nil {
+						t.Fatalf("freshclient.UpdateAsset() error = %v", err)
+					}
+				},
+				Config: testAccAssetResourceConfig("TestAccAsset", "Updated by Terraform"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("fresh_asset.test", "name", "TestAccAsset"),
+			},
+			// Out of band deletion, the asset is recreated
+			{
+				PreConfig: func() {
+					id, _ := strconv.ParseInt(displayID, 10, 64)
+					if err := client.DeleteAsset(context.Background(), freshclient.AssetDetails{DisplayID: id}); err != nil {
+						t.Fatalf("freshclient.DeleteAsset() error = %v", err)
+					}
+				},
+				Config: testAccAssetResourceConfig("TestAccAsset", "Updated by Terraform"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith("fresh_asset.test", "display_id", func(value string) error {
+					if value == displayID {
+						return fmt.Errorf("display_id = %s, want a new asset", value)
+					}
+					displayID = value
+					return nil
+				}),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAssetResourceAssignment(t *testing.T) {
+	testAccSetup(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid values are rejected during plan
+			{
+				Config:      testAccAssetResourceAssignmentConfig("huge", "loaner"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			// Create with assignment fields
+			{
+				Config: testAccAssetResourceAssignmentConfig("high", "loaner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "impact", "high"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "usage_type", "loaner"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "asset_tag", "TAG-ACC-1"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "end_of_life", "2030-12-31"),
+				),
+			},
+			// Update in place
+			{
+				Config: testAccAssetResourceAssignmentConfig("medium", "permanent"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "impact", "medium"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "usage_type", "permanent"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssetResourceAssignmentConfig(impact string, usageType string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "test" {
+  name          = "TestAccAssetAssignment"
+  asset_type_id = data.fresh_asset_type.test.id
+  asset_tag     = "TAG-ACC-1"
+  impact        = %[2]q
+  usage_type    = %[3]q
+  end_of_life   = "2030-12-31"
+}
+`, testAccAssetTypeName, impact, usageType)
+}
+
+func TestAccAssetResourceTrash(t *testing.T) {
+	client, _ := testAccSetup(t)
+	ctx := context.Background()
+
+	assetType, err := client.GetAssetType(ctx, testAccAssetTypeName)
+	if err != nil {
+		t.Fatalf("freshclient.GetAssetType() error = %v", err)
+	}
+
+	// Trash an asset to be adopted by the configuration
+	trashed, err := client.CreateAsset(ctx, freshclient.AssetDetails{Name: "TestAccAssetTrash", AssetTypeID: assetType.ID})
+	if err != nil {
+		t.Fatalf("freshclient.CreateAsset() error = %v", err)
+	}
+	if err := client.DeleteAsset(ctx, *trashed); err != nil {
+		t.Fatalf("freshclient.DeleteAsset() error = %v", err)
+	}
+	displayID := strconv.FormatInt(trashed.DisplayID, 10)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			assets, err := client.ListTrashedAssets(ctx)
+			if err != nil {
+				return err
+			}
+			for _, asset := range assets {
+				if asset.DisplayID == trashed.DisplayID {
+					return fmt.Errorf("asset %d is still in the trash", asset.DisplayID)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Restore the trashed asset on create
+			{
+				Config: testAccAssetResourceTrashConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "display_id", displayID),
+					resource.TestCheckResourceAttr("fresh_asset.test", "description", "Restored by Terraform"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "deletion_mode", "permanent"),
+				),
+			},
+			// Delete testing permanently deletes the asset in TestCase
+		},
+	})
+}
+
+func testAccAssetResourceTrashConfig() string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "test" {
+  name               = "TestAccAssetTrash"
+  asset_type_id      = data.fresh_asset_type.test.id
+  description        = "Restored by Terraform"
+  deletion_mode      = "permanent"
+  restore_from_trash = true
+}
+`, testAccAssetTypeName)
+}
+
+func TestAccAssetResourceTypeFields(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	server.AddAssetTypeField(1, "serial_number", "Serial Number", "text")
+	costKey := server.AddAssetTypeField(1, "cost", "Cost", "number")
+	leasedKey := server.AddAssetTypeField(1, "leased", "Leased", "checkbox")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with type fields
+			{
+				Config: testAccAssetResourceTypeFieldsConfig(`serial_number = "SN-1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.%", "1"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.serial_number", "SN-1"),
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "type_fields.serial_number", "SN-1"),
+				),
+			},
+			// Update and add type fields, values are sent with the data type of the field
+			{
+				Config: testAccAssetResourceTypeFieldsConfig(`serial_number = "SN-2", cost = "1200", leased = "true"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.%", "3"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.serial_number", "SN-2"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.cost", "1200"),
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.leased", "true"),
+					func(s *terraform.State) error {
+						typeFields, _ := server.Asset(1)["type_fields"].(map[string]interface{})
+						if typeFields[costKey] != json.Number("1200") || typeFields[leasedKey] != true {
+							return fmt.Errorf("type_fields = %v, want number %s and boolean %s", typeFields, costKey, leasedKey)
+						}
+						return nil
+					},
+				),
+			},
+			// Values not matching the data type of the field are rejected
+			{
+				Config:      testAccAssetResourceTypeFieldsConfig(`serial_number = "SN-2", cost = "1200", leased = "yes"`),
+				ExpectError: regexp.MustCompile(`"yes" is not a boolean`),
+			},
+			// Names the asset type has no field for are rejected
+			{
+				Config:      testAccAssetResourceTypeFieldsConfig(`serial_number = "SN-2", cost = "1200", warranty = "3y"`),
+				ExpectError: regexp.MustCompile(`no type field named "warranty"`),
+			},
+			// Fields removed from the configuration are cleared
+			{
+				Config: testAccAssetResourceTypeFieldsConfig(`serial_number = "SN-2"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.%", "1"),
+					func(s *terraform.State) error {
+						typeFields, _ := server.Asset(1)["type_fields"].(map[string]interface{})
+						if typeFields[costKey] != nil || typeFields[leasedKey] != nil {
+							return fmt.Errorf("type_fields = %v, want nil %s and %s", typeFields, costKey, leasedKey)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestAccAssetResourceInheritedTypeFields tests that fields inherited from a
+// parent asset type are sent with the suffix of the parent on create.
+func TestAccAssetResourceInheritedTypeFields(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	serialNumberKey := server.AddAssetTypeField(1, "serial_number", "Serial Number", "text")
+	server.AddAssetType("TestAccChildAssetType", 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "fresh_asset_type" "test" {
+  name = "TestAccChildAssetType"
+}
+
+resource "fresh_asset" "test" {
+  name          = "TestAccAssetInheritedTypeFields"
+  asset_type_id = data.fresh_asset_type.test.id
+  type_fields   = { serial_number = "SN-1" }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.serial_number", "SN-1"),
+					func(s *terraform.State) error {
+						typeFields, _ := server.Asset(1)["type_fields"].(map[string]interface{})
+						if typeFields[serialNumberKey] != "SN-1" {
+							return fmt.Errorf("%s = %v, want SN-1", serialNumberKey, typeFields[serialNumberKey])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestAccAssetResourceNumberedTypeFields tests that type fields whose name
+// ends in _<digits> keep their name and read back without a diff.
+func TestAccAssetResourceNumberedTypeFields(t *testing.T) {
+	_, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	diskKey := server.AddAssetTypeField(1, "disk_2", "Disk 2", "text")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetResourceTypeFieldsConfig(`disk_2 = "512 GB"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.disk_2", "512 GB"),
+					resource.TestCheckResourceAttr("data.fresh_asset.test", "type_fields.disk_2", "512 GB"),
+					func(s *terraform.State) error {
+						typeFields, _ := server.Asset(1)["type_fields"].(map[string]interface{})
+						if typeFields[diskKey] != "512 GB" {
+							return fmt.Errorf("%s = %v, want 512 GB", diskKey, typeFields[diskKey])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAssetResourceTypeFieldsConfig(`disk_2 = "1 TB"`),
+				Check:  resource.TestCheckResourceAttr("fresh_asset.test", "type_fields.disk_2", "1 TB"),
+			},
+		},
+	})
+}
+
+func testAccAssetResourceTypeFieldsConfig(typeFields string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "test" {
+  name          = "TestAccAssetTypeFields"
+  asset_type_id = data.fresh_asset_type.test.id
+  type_fields   = { %[2]s }
+}
+
+data "fresh_asset" "test" {
+  display_id = fresh_asset.test.display_id
+}
+`, testAccAssetTypeName, typeFields)
+}
+
+// testAccCheckAssetDestroyed checks that the asset is gone from the API.
+func testAccCheckAssetDestroyed(client *freshclient.Client, displayID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := strconv.ParseInt(*displayID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetAsset(context.Background(), id)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("asset %d still exists: %v", id, err)
+	}
+}
+
+func testAccAssetResourceConfig(name string, description string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "test" {
+  name          = %[2]q
+  asset_type_id = data.fresh_asset_type.test.id
+  description   = %[3]q
+}
+`, testAccAssetTypeName, name, description)
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure AssetTypeResource satisfies various resource interfaces.
+var _ resource.Resource = &AssetTypeResource{}
+var _ resource.ResourceWithImportState = &AssetTypeResource{}
+
+// NewAssetTypeResource returns a new resource.
+func NewAssetTypeResource() resource.Resource {
+	return &AssetTypeResource{}
+}
+
+// AssetTypeResource defines the resource implementation.
+type AssetTypeResource struct {
+	client *freshclient.Client
+}
+
+// AssetTypeResourceModel describes the resource data model.
+type AssetTypeResourceModel struct {
+	CreatedAt         types.String `tfsdk:"created_at"`
+	Description       types.String `tfsdk:"description"`
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ParentAssetTypeID types.Int64  `tfsdk:"parent_asset_type_id"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+	Visible           types.Bool   `tfsdk:"visible"`
+}
+
+// fromFreshAssetType converts an asset type from the API, root asset types
+// have no parent_asset_type_id.
+func (m AssetTypeResourceModel) fromFreshAssetType(assetType freshclient.AssetTypeDetails) AssetTypeResourceModel {
+	parentAssetTypeID := types.Int64Null()
+	if assetType.ParentAssetTypeID != 0 {
+		parentAssetTypeID = types.Int64Value(assetType.ParentAssetTypeID)
+	}
+
+	return AssetTypeResourceModel{
+		CreatedAt:         types.StringValue(assetType.CreatedAt),
+		Description:       types.StringValue(assetType.Description),
+		ID:                types.Int64Value(assetType.ID),
+		Name:              types.StringValue(assetType.Name),
+		ParentAssetTypeID: parentAssetTypeID,
+		UpdatedAt:         types.StringValue(assetType.UpdatedAt),
+		Visible:           types.BoolValue(assetType.Visible),
+	}
+}
+
+func (m AssetTypeResourceModel) toFreshAssetType() freshclient.AssetTypeDetails {
+	return freshclient.AssetTypeDetails{
+		Description:       m.Description.ValueString(),
+		ID:                m.ID.ValueInt64(),
+		Name:              m.Name.ValueString(),
+		ParentAssetTypeID: m.ParentAssetTypeID.ValueInt64(),
+		Visible:           m.Visible.ValueBool(),
+	}
+}
+
+// Metadata returns the metadata for the resource.
+func (r *AssetTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_type"
+}
+
+// Schema returns the schema for the resource.
+func (r *AssetTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Asset Type Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the asset type",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the asset type",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the asset type, unique among the children of its parent",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parent_asset_type_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the parent asset type, leave unset for a root asset type. " +
+					"FreshService does not allow moving an asset type, changing this replaces it",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+			"visible": schema.BoolAttribute{
+				MarkdownDescription: "Whether the asset type is visible, defaults to `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AssetTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *AssetTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AssetTypeResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetTypeDetails, err := r.client.CreateAssetType(ctx, data.toFreshAssetType())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating asset type", err, attributeFieldPath(AssetTypeResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAssetType(*assetTypeDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *AssetTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AssetTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetTypeDetails, err := r.client.GetAssetTypeByID(ctx, data.ID.ValueInt64())
+
+	// The asset type was deleted outside of Terraform, drop it from state so
+	// it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Asset type not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting asset type", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAssetType(*assetTypeDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *AssetTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssetTypeResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetTypeDetails, err := r.client.UpdateAssetType(ctx, data.toFreshAssetType())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating asset type", err, attributeFieldPath(AssetTypeResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshAssetType(*assetTypeDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *AssetTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AssetTypeResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAssetType(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting asset type", err)
+		return
+	}
+}
+
+// ImportState imports an asset type by its ID.
+func (r *AssetTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the asset type, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssetTypeResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var ids []string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAssetTypesDestroyed(client, &ids),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAssetTypeResourceConfig("Created by Terraform", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset_type.parent", "name", "TestAccAssetTypeParent"),
+					resource.TestCheckResourceAttr("fresh_asset_type.parent", "description", ""),
+					resource.TestCheckResourceAttr("fresh_asset_type.parent", "visible", "true"),
+					resource.TestCheckResourceAttrPair("fresh_asset_type.parent", "parent_asset_type_id", "data.fresh_asset_type.test", "id"),
+					resource.TestCheckResourceAttr("fresh_asset_type.test", "name", "TestAccAssetType"),
+					resource.TestCheckResourceAttr("fresh_asset_type.test", "description", "Created by Terraform"),
+					resource.TestCheckResourceAttrPair("fresh_asset_type.test", "parent_asset_type_id", "fresh_asset_type.parent", "id"),
+					resource.TestCheckResourceAttrWith("fresh_asset_type.parent", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("fresh_asset_type.test", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_asset_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing
+			{
+				Config: testAccAssetTypeResourceConfig("Updated by Terraform", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset_type.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_asset_type.test", "description", "Updated by Terraform"),
+					resource.TestCheckResourceAttr("fresh_asset_type.test", "visible", "false"),
+				),
+			},
+			// Out of band deletion testing
+			{
+				PreConfig: func() {
+					id, _ := strconv.ParseInt(ids[1], 10, 64)
+					if err := client.DeleteAssetType(context.Background(), id); err != nil {
+						t.Fatalf("freshclient.DeleteAssetType() error = %v", err)
+					}
+				},
+				Config: testAccAssetTypeResourceConfig("Updated by Terraform", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_asset_type.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith("fresh_asset_type.test", "id", func(value string) error {
+					ids = append(ids, value)
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+// testAccCheckAssetTypesDestroyed checks that the asset types are gone from the API.
+func testAccCheckAssetTypesDestroyed(client *freshclient.Client, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, value := range *ids {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.GetAssetTypeByID(context.Background(), id)
+			if !freshclient.IsNotFound(err) {
+				return fmt.Errorf("asset type %d still exists: %v", id, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetTypeResourceConfig(description string, visible bool) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset_type" "parent" {
+  name                 = "TestAccAssetTypeParent"
+  parent_asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset_type" "test" {
+  name                 = "TestAccAssetType"
+  description          = %[2]q
+  parent_asset_type_id = fresh_asset_type.parent.id
+  visible              = %[3]t
+}
+`, testAccAssetTypeName, description, visible)
+}
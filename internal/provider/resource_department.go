@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure DepartmentResource satisfies various resource interfaces.
+var _ resource.Resource = &DepartmentResource{}
+var _ resource.ResourceWithImportState = &DepartmentResource{}
+
+// NewDepartmentResource returns a new resource.
+func NewDepartmentResource() resource.Resource {
+	return &DepartmentResource{}
+}
+
+// DepartmentResource defines the resource implementation.
+type DepartmentResource struct {
+	client *freshclient.Client
+}
+
+// DepartmentResourceModel describes the resource data model.
+type DepartmentResourceModel struct {
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	CustomFields types.Map      `tfsdk:"custom_fields"`
+	Description  types.String   `tfsdk:"description"`
+	Domains      []types.String `tfsdk:"domains"`
+	HeadUserID   types.Int64    `tfsdk:"head_user_id"`
+	ID           types.Int64    `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	PrimeUserID  types.Int64    `tfsdk:"prime_user_id"`
+	UpdatedAt    types.String   `tfsdk:"updated_at"`
+}
+
+// fromFreshDepartment converts a department from the API, only the custom
+// fields managed by m are kept.
+func (m DepartmentResourceModel) fromFreshDepartment(department freshclient.DepartmentDetails) DepartmentResourceModel {
+	domains := make([]types.String, 0, len(department.Domains))
+	for _, domain := range department.Domains {
+		domains = append(domains, types.StringValue(domain))
+	}
+
+	return DepartmentResourceModel{
+		CreatedAt:    types.StringValue(department.CreatedAt),
+		CustomFields: managedCustomFieldsValue(department.CustomFields, m.CustomFields),
+		Description:  types.StringValue(department.Description),
+		Domains:      domains,
+		HeadUserID:   optionalInt64(department.HeadUserID),
+		ID:           types.Int64Value(department.ID),
+		Name:         types.StringValue(department.Name),
+		PrimeUserID:  optionalInt64(department.PrimeUserID),
+		UpdatedAt:    types.StringValue(department.UpdatedAt),
+	}
+}
+
+func (m DepartmentResourceModel) toFreshDepartment() freshclient.DepartmentDetails {
+	domains := make([]string, 0, len(m.Domains))
+	for _, domain := range m.Domains {
+		domains = append(domains, domain.ValueString())
+	}
+
+	return freshclient.DepartmentDetails{
+		CustomFields: typeFieldsFromValue(m.CustomFields),
+		Description:  m.Description.ValueString(),
+		Domains:      domains,
+		HeadUserID:   m.HeadUserID.ValueInt64(),
+		ID:           m.ID.ValueInt64(),
+		Name:         m.Name.ValueString(),
+		PrimeUserID:  m.PrimeUserID.ValueInt64(),
+	}
+}
+
+// Metadata returns the metadata for the resource.
+func (r *DepartmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_department"
+}
+
+// Schema returns the schema for the resource.
+func (r *DepartmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Department Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_fields": schema.MapAttribute{
+				MarkdownDescription: "Custom department fields by name, values are sent as strings. " +
+					"Only the fields set here are managed, removing a field clears its value in FreshService",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the department",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"domains": schema.SetAttribute{
+				MarkdownDescription: "Email domains of the department, requesters with a matching email address are added to it",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Optional:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"head_user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user heading the department",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the department",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the department, unique regardless of case",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"prime_user_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the prime user of the department, e.g. the approver of its requests",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *DepartmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *DepartmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DepartmentResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	departmentDetails, err := r.client.CreateDepartment(ctx, data.toFreshDepartment())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating department", err, attributeFieldPath(DepartmentResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshDepartment(*departmentDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *DepartmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DepartmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	departmentDetails, err := r.client.GetDepartment(ctx, data.ID.ValueInt64())
+
+	// The department was deleted outside of Terraform, drop it from state so
+	// it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Department not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting department", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshDepartment(*departmentDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *DepartmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DepartmentResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Custom fields removed from the plan are cleared like asset type fields.
+	department := data.toFreshDepartment()
+	department.CustomFields = updatedFieldsFromValue(data.CustomFields, state.CustomFields)
+
+	departmentDetails, err := r.client.UpdateDepartment(ctx, department)
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating department", err, attributeFieldPath(DepartmentResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshDepartment(*departmentDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *DepartmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DepartmentResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDepartment(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting department", err)
+		return
+	}
+}
+
+// ImportState imports a department by its ID, custom fields are managed once
+// they are set in the configuration.
+func (r *DepartmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the department, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
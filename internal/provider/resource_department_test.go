@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDepartmentResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDepartmentDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "fresh_department" "test" {
+  name        = "TestAcc Finance"
+  description = "Accounting and payroll"
+  domains     = ["finance.example.com", "payroll.example.com"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_department.test", "name", "TestAcc Finance"),
+					resource.TestCheckResourceAttr("fresh_department.test", "description", "Accounting and payroll"),
+					resource.TestCheckResourceAttr("fresh_department.test", "domains.#", "2"),
+					resource.TestCheckTypeSetElemAttr("fresh_department.test", "domains.*", "payroll.example.com"),
+					resource.TestCheckNoResourceAttr("fresh_department.test", "head_user_id"),
+					resource.TestCheckResourceAttrWith("fresh_department.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_department.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing, unset arguments fall back to their defaults
+			{
+				Config: `
+resource "fresh_department" "test" {
+  name = "TestAcc Accounting"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_department.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_department.test", "name", "TestAcc Accounting"),
+					resource.TestCheckResourceAttr("fresh_department.test", "description", ""),
+					resource.TestCheckResourceAttr("fresh_department.test", "domains.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDepartmentResourceCustomFields(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDepartmentDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDepartmentResourceCustomFieldsConfig("CC-100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_department.test", "custom_fields.%", "1"),
+					resource.TestCheckResourceAttr("fresh_department.test", "custom_fields.cost_center", "CC-100"),
+					resource.TestCheckResourceAttrWith("fresh_department.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// Custom fields changed outside of Terraform and not in the configuration are ignored
+			{
+				PreConfig: func() {
+					departmentID, _ := strconv.ParseInt(id, 10, 64)
+					department, err := client.GetDepartment(context.Background(), departmentID)
+					if err != nil {
+						t.Fatalf("freshclient.GetDepartment() error = %v", err)
+					}
+					department.CustomFields = map[string]interface{}{"floor": "3"}
+					if _, err := client.UpdateDepartment(context.Background(), *department); err != nil {
+						t.Fatalf("freshclient.UpdateDepartment() error = %v", err)
+					}
+				},
+				Config:   testAccDepartmentResourceCustomFieldsConfig("CC-100"),
+				PlanOnly: true,
+			},
+			// Update in place testing
+			{
+				Config: testAccDepartmentResourceCustomFieldsConfig("CC-200"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_department.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("fresh_department.test", "custom_fields.cost_center", "CC-200"),
+			},
+			// Custom fields removed from the configuration are cleared
+			{
+				Config: `
+resource "fresh_department" "test" {
+  name = "TestAcc Payroll"
+
+  custom_fields = {
+    floor = "4"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_department.test", "custom_fields.%", "1"),
+					resource.TestCheckResourceAttr("fresh_department.test", "custom_fields.floor", "4"),
+					func(s *terraform.State) error {
+						departmentID, _ := strconv.ParseInt(id, 10, 64)
+						department, err := client.GetDepartment(context.Background(), departmentID)
+						if err != nil {
+							return err
+						}
+						if value := department.CustomFields["cost_center"]; value != nil {
+							return fmt.Errorf("cost_center = %v, want nil", value)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDepartmentDestroyed checks that the department is gone from the API.
+func testAccCheckDepartmentDestroyed(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		departmentID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetDepartment(context.Background(), departmentID)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("department %d still exists: %v", departmentID, err)
+	}
+}
+
+func testAccDepartmentResourceCustomFieldsConfig(costCenter string) string {
+	return fmt.Sprintf(`
+resource "fresh_department" "test" {
+  name = "TestAcc Payroll"
+
+  custom_fields = {
+    cost_center = %q
+  }
+}
+`, costCenter)
+}
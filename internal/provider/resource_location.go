@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure LocationResource satisfies various resource interfaces.
+var _ resource.Resource = &LocationResource{}
+var _ resource.ResourceWithImportState = &LocationResource{}
+
+// NewLocationResource returns a new resource.
+func NewLocationResource() resource.Resource {
+	return &LocationResource{}
+}
+
+// LocationResource defines the resource implementation.
+type LocationResource struct {
+	client *freshclient.Client
+}
+
+// LocationResourceModel describes the resource data model.
+type LocationResourceModel struct {
+	City             types.String `tfsdk:"city"`
+	Country          types.String `tfsdk:"country"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	ID               types.Int64  `tfsdk:"id"`
+	Line1            types.String `tfsdk:"line1"`
+	Line2            types.String `tfsdk:"line2"`
+	Name             types.String `tfsdk:"name"`
+	ParentLocationID types.Int64  `tfsdk:"parent_location_id"`
+	PrimaryContactID types.Int64  `tfsdk:"primary_contact_id"`
+	State            types.String `tfsdk:"state"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Zipcode          types.String `tfsdk:"zipcode"`
+}
+
+// fromFreshLocation converts a location from the API, top level locations
+// have no parent_location_id.
+func (m LocationResourceModel) fromFreshLocation(location freshclient.LocationDetails) LocationResourceModel {
+	return LocationResourceModel{
+		City:             types.StringValue(location.Address.City),
+		Country:          types.StringValue(location.Address.Country),
+		CreatedAt:        types.StringValue(location.CreatedAt),
+		ID:               types.Int64Value(location.ID),
+		Line1:            types.StringValue(location.Address.Line1),
+		Line2:            types.StringValue(location.Address.Line2),
+		Name:             types.StringValue(location.Name),
+		ParentLocationID: optionalInt64(location.ParentLocationID),
+		PrimaryContactID: optionalInt64(location.PrimaryContactID),
+		State:            types.StringValue(location.Address.State),
+		UpdatedAt:        types.StringValue(location.UpdatedAt),
+		Zipcode:          types.StringValue(location.Address.Zipcode),
+	}
+}
+
+func (m LocationResourceModel) toFreshLocation() freshclient.LocationDetails {
+	return freshclient.LocationDetails{
+		Address: freshclient.LocationAddress{
+			City:    m.City.ValueString(),
+			Country: m.Country.ValueString(),
+			Line1:   m.Line1.ValueString(),
+			Line2:   m.Line2.ValueString(),
+			State:   m.State.ValueString(),
+			Zipcode: m.Zipcode.ValueString(),
+		},
+		ID:               m.ID.ValueInt64(),
+		Name:             m.Name.ValueString(),
+		ParentLocationID: m.ParentLocationID.ValueInt64(),
+		PrimaryContactID: m.PrimaryContactID.ValueInt64(),
+	}
+}
+
+// locationFieldPath returns the attribute path of a field reported by the
+// API, the fields of the address are flattened into the resource.
+func locationFieldPath(field string) (path.Path, bool) {
+	return attributeFieldPath(LocationResourceModel{})(strings.TrimPrefix(field, "address."))
+}
+
+// Metadata returns the metadata for the resource.
+func (r *LocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location"
+}
+
+// Schema returns the schema for the resource.
+func (r *LocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Location Resource, locations form a hierarchy like region, city and office",
+
+		Attributes: map[string]schema.Attribute{
+			"city": schema.StringAttribute{
+				MarkdownDescription: "City of the address",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "Country of the address",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the location",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"line1": schema.StringAttribute{
+				MarkdownDescription: "First line of the address, e.g. the street",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"line2": schema.StringAttribute{
+				MarkdownDescription: "Second line of the address",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the location, unique among the children of its parent",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"parent_location_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the parent location, leave unset for a top level location",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"primary_contact_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the user who is the primary contact of the location",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the address",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+			"zipcode": schema.StringAttribute{
+				MarkdownDescription: "Zip code of the address",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *LocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *LocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LocationResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locationDetails, err := r.client.CreateLocation(ctx, data.toFreshLocation())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating location", err, locationFieldPath)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshLocation(*locationDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *LocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LocationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locationDetails, err := r.client.GetLocation(ctx, data.ID.ValueInt64())
+
+	// The location was deleted outside of Terraform, drop it from state so it
+	// gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Location not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting location", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshLocation(*locationDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *LocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LocationResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locationDetails, err := r.client.UpdateLocation(ctx, data.toFreshLocation())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating location", err, locationFieldPath)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshLocation(*locationDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *LocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LocationResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLocation(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting location", err)
+		return
+	}
+}
+
+// ImportState imports a location by its ID.
+func (r *LocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the location, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLocationResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var ids []string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLocationsDestroyed(client, &ids),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLocationResourceConfig("berlin", "3rd floor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("fresh_location.eu", "parent_location_id"),
+					resource.TestCheckResourceAttrPair("fresh_location.berlin", "parent_location_id", "fresh_location.eu", "id"),
+					resource.TestCheckResourceAttrPair("fresh_location.office", "parent_location_id", "fresh_location.berlin", "id"),
+					resource.TestCheckResourceAttr("fresh_location.office", "line1", "Friedrichstraße 123"),
+					resource.TestCheckResourceAttr("fresh_location.office", "line2", "3rd floor"),
+					resource.TestCheckResourceAttr("fresh_location.office", "state", ""),
+					resource.TestCheckResourceAttrWith("fresh_location.eu", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("fresh_location.berlin", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("fresh_location.office", "id", func(value string) error {
+						ids = append(ids, value)
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_location.office",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Moving a location and changing its address updates it in place
+			{
+				Config: testAccLocationResourceConfig("eu", "4th floor"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_location.office", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("fresh_location.berlin", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fresh_location.office", "parent_location_id", "fresh_location.eu", "id"),
+					resource.TestCheckResourceAttr("fresh_location.office", "line2", "4th floor"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckLocationsDestroyed checks that the locations are gone from the API.
+func testAccCheckLocationsDestroyed(client *freshclient.Client, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, value := range *ids {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.GetLocation(context.Background(), id)
+			if !freshclient.IsNotFound(err) {
+				return fmt.Errorf("location %d still exists: %v", id, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccLocationResourceConfig(parent string, line2 string) string {
+	return fmt.Sprintf(`
+resource "fresh_location" "eu" {
+  name = "TestAcc EU"
+}
+
+resource "fresh_location" "berlin" {
+  name               = "TestAcc Berlin"
+  parent_location_id = fresh_location.eu.id
+}
+
+resource "fresh_location" "office" {
+  name               = "TestAcc Office 3"
+  parent_location_id = fresh_location.%[1]s.id
+  line1              = "Friedrichstraße 123"
+  line2              = %[2]q
+  city               = "Berlin"
+  country            = "Germany"
+  zipcode            = "10117"
+}
+`, parent, line2)
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure RelationshipTypeResource satisfies various resource interfaces.
+var _ resource.Resource = &RelationshipTypeResource{}
+var _ resource.ResourceWithImportState = &RelationshipTypeResource{}
+
+// NewRelationshipTypeResource returns a new resource.
+func NewRelationshipTypeResource() resource.Resource {
+	return &RelationshipTypeResource{}
+}
+
+// RelationshipTypeResource defines the resource implementation.
+type RelationshipTypeResource struct {
+	client *freshclient.Client
+}
+
+// RelationshipTypeResourceModel describes the resource data model.
+type RelationshipTypeResourceModel struct {
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Description        types.String `tfsdk:"description"`
+	DownstreamRelation types.String `tfsdk:"downstream_relation"`
+	ID                 types.Int64  `tfsdk:"id"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	UpstreamRelation   types.String `tfsdk:"upstream_relation"`
+}
+
+func (m RelationshipTypeResourceModel) fromFreshRelationshipType(relationshipType freshclient.RelationshipTypeDetails) RelationshipTypeResourceModel {
+	return RelationshipTypeResourceModel{
+		CreatedAt:          types.StringValue(relationshipType.CreatedAt),
+		Description:        types.StringValue(relationshipType.Description),
+		DownstreamRelation: types.StringValue(relationshipType.DownstreamRelation),
+		ID:                 types.Int64Value(relationshipType.ID),
+		UpdatedAt:          types.StringValue(relationshipType.UpdatedAt),
+		UpstreamRelation:   types.StringValue(relationshipType.UpstreamRelation),
+	}
+}
+
+func (m RelationshipTypeResourceModel) toFreshRelationshipType() freshclient.RelationshipTypeDetails {
+	return freshclient.RelationshipTypeDetails{
+		Description:        m.Description.ValueString(),
+		DownstreamRelation: m.DownstreamRelation.ValueString(),
+		ID:                 m.ID.ValueInt64(),
+		UpstreamRelation:   m.UpstreamRelation.ValueString(),
+	}
+}
+
+// Metadata returns the metadata for the resource.
+func (r *RelationshipTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationship_type"
+}
+
+// Schema returns the schema for the resource.
+func (r *RelationshipTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Relationship Type Resource",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the relationship type",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"downstream_relation": schema.StringAttribute{
+				MarkdownDescription: "Relation from the primary to the secondary entity, e.g. `Depends on`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the relationship type",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+			"upstream_relation": schema.StringAttribute{
+				MarkdownDescription: "Relation from the secondary to the primary entity, e.g. `Used by`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RelationshipTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *RelationshipTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RelationshipTypeResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationshipTypeDetails, err := r.client.CreateRelationshipType(ctx, data.toFreshRelationshipType())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating relationship type", err, attributeFieldPath(RelationshipTypeResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRelationshipType(*relationshipTypeDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *RelationshipTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RelationshipTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationshipTypeDetails, err := r.client.GetRelationshipType(ctx, data.ID.ValueInt64())
+
+	// The relationship type was deleted outside of Terraform, drop it from
+	// state so it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Relationship type not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting relationship type", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRelationshipType(*relationshipTypeDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *RelationshipTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RelationshipTypeResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationshipTypeDetails, err := r.client.UpdateRelationshipType(ctx, data.toFreshRelationshipType())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating relationship type", err, attributeFieldPath(RelationshipTypeResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRelationshipType(*relationshipTypeDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *RelationshipTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RelationshipTypeResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRelationshipType(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting relationship type", err)
+		return
+	}
+}
+
+// ImportState imports a relationship type by its ID.
+func (r *RelationshipTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the relationship type, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
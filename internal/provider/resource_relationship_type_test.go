@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRelationshipTypeResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRelationshipTypeDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRelationshipTypeResourceConfig("TestAcc Replicated From"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_relationship_type.test", "downstream_relation", "TestAcc Replicates To"),
+					resource.TestCheckResourceAttr("fresh_relationship_type.test", "upstream_relation", "TestAcc Replicated From"),
+					resource.TestCheckResourceAttr("fresh_relationship_type.test", "description", ""),
+					resource.TestCheckResourceAttrPair("fresh_asset_relationship.test", "relationship_type_id", "fresh_relationship_type.test", "id"),
+					resource.TestCheckResourceAttrWith("fresh_relationship_type.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_relationship_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing
+			{
+				Config: testAccRelationshipTypeResourceConfig("TestAcc Replica Of"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_relationship_type.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("fresh_asset_relationship.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("fresh_relationship_type.test", "upstream_relation", "TestAcc Replica Of"),
+			},
+		},
+	})
+}
+
+// testAccCheckRelationshipTypeDestroyed checks that the relationship type is gone from the API.
+func testAccCheckRelationshipTypeDestroyed(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		relationshipTypeID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetRelationshipType(context.Background(), relationshipTypeID)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("relationship type %d still exists: %v", relationshipTypeID, err)
+	}
+}
+
+func testAccRelationshipTypeResourceConfig(upstreamRelation string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_relationship_type" "test" {
+  downstream_relation = "TestAcc Replicates To"
+  upstream_relation   = %[2]q
+}
+
+resource "fresh_asset" "primary" {
+  name          = "TestAccRelationshipTypePrimary"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset" "replica" {
+  name          = "TestAccRelationshipTypeReplica"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset_relationship" "test" {
+  primary_id           = fresh_asset.primary.display_id
+  secondary_id         = fresh_asset.replica.display_id
+  relationship_type_id = fresh_relationship_type.test.id
+}
+`, testAccAssetTypeName, upstreamRelation)
+}
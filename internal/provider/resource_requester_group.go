@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure RequesterGroupResource satisfies various resource interfaces.
+var _ resource.Resource = &RequesterGroupResource{}
+var _ resource.ResourceWithImportState = &RequesterGroupResource{}
+
+// NewRequesterGroupResource returns a new resource.
+func NewRequesterGroupResource() resource.Resource {
+	return &RequesterGroupResource{}
+}
+
+// RequesterGroupResource defines the resource implementation.
+type RequesterGroupResource struct {
+	client *freshclient.Client
+}
+
+// RequesterGroupResourceModel describes the resource data model.
+type RequesterGroupResourceModel struct {
+	CreatedAt   types.String `tfsdk:"created_at"`
+	Description types.String `tfsdk:"description"`
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (m RequesterGroupResourceModel) fromFreshRequesterGroup(requesterGroup freshclient.RequesterGroupDetails) RequesterGroupResourceModel {
+	return RequesterGroupResourceModel{
+		CreatedAt:   types.StringValue(requesterGroup.CreatedAt),
+		Description: types.StringValue(requesterGroup.Description),
+		ID:          types.Int64Value(requesterGroup.ID),
+		Name:        types.StringValue(requesterGroup.Name),
+		Type:        types.StringValue(requesterGroup.Type),
+		UpdatedAt:   types.StringValue(requesterGroup.UpdatedAt),
+	}
+}
+
+func (m RequesterGroupResourceModel) toFreshRequesterGroup() freshclient.RequesterGroupDetails {
+	return freshclient.RequesterGroupDetails{
+		Description: m.Description.ValueString(),
+		ID:          m.ID.ValueInt64(),
+		Name:        m.Name.ValueString(),
+		Type:        m.Type.ValueString(),
+	}
+}
+
+// Metadata returns the metadata for the resource.
+func (r *RequesterGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_requester_group"
+}
+
+// Schema returns the schema for the resource.
+func (r *RequesterGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Requester Group Resource. Members of manual groups are managed with the " +
+			"`fresh_requester_group_member` resource. The FreshService API does not expose the rules of rule based " +
+			"groups, so this resource only creates the group and its rules have to be set up in the FreshService UI",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the group",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group, unique regardless of case",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the group, `manual` or `rule_based`, changing it replaces the group. " +
+					"The rules of a `rule_based` group cannot be managed with Terraform, a new group has no rules and no members " +
+					"until they are set up in the FreshService UI",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(freshclient.RequesterGroupTypeManual),
+				Validators: []validator.String{
+					stringvalidator.OneOf(freshclient.RequesterGroupTypeManual, freshclient.RequesterGroupTypeRuleBased),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RequesterGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *RequesterGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RequesterGroupResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requesterGroupDetails, err := r.client.CreateRequesterGroup(ctx, data.toFreshRequesterGroup())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating requester group", err, attributeFieldPath(RequesterGroupResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRequesterGroup(*requesterGroupDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *RequesterGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RequesterGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requesterGroupDetails, err := r.client.GetRequesterGroup(ctx, data.ID.ValueInt64())
+
+	// The requester group was deleted outside of Terraform, drop it from state
+	// so it gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Requester group not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting requester group", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRequesterGroup(*requesterGroupDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *RequesterGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RequesterGroupResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requesterGroupDetails, err := r.client.UpdateRequesterGroup(ctx, data.toFreshRequesterGroup())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating requester group", err, attributeFieldPath(RequesterGroupResourceModel{}))
+		return
+	}
+
+	// Save data into Terraform state
+	data = data.fromFreshRequesterGroup(*requesterGroupDetails)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *RequesterGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RequesterGroupResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRequesterGroup(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting requester group", err)
+		return
+	}
+}
+
+// ImportState imports a requester group by its ID.
+func (r *RequesterGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the requester group, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure RequesterGroupMemberResource satisfies various resource interfaces.
+var _ resource.Resource = &RequesterGroupMemberResource{}
+var _ resource.ResourceWithImportState = &RequesterGroupMemberResource{}
+
+// NewRequesterGroupMemberResource returns a new resource.
+func NewRequesterGroupMemberResource() resource.Resource {
+	return &RequesterGroupMemberResource{}
+}
+
+// RequesterGroupMemberResource defines the resource implementation.
+type RequesterGroupMemberResource struct {
+	client *freshclient.Client
+}
+
+// RequesterGroupMemberResourceModel describes the resource data model.
+type RequesterGroupMemberResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	RequesterGroupID types.Int64  `tfsdk:"requester_group_id"`
+	RequesterID      types.Int64  `tfsdk:"requester_id"`
+}
+
+// requesterGroupMemberID returns the ID of a membership, the group and
+// requester IDs separated by a slash.
+func requesterGroupMemberID(requesterGroupID int64, requesterID int64) string {
+	return fmt.Sprintf("%d/%d", requesterGroupID, requesterID)
+}
+
+// Metadata returns the metadata for the resource.
+func (r *RequesterGroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_requester_group_member"
+}
+
+// Schema returns the schema for the resource.
+func (r *RequesterGroupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Requester Group Member Resource, adds a single requester to a manual requester group. " +
+			"Other members of the group are left alone so the members of a group can be managed from several configurations",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the membership, `<requester_group_id>/<requester_id>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"requester_group_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the manual requester group, see the `fresh_requester_group` resource",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"requester_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the requester",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *RequesterGroupMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *RequesterGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RequesterGroupMemberResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddRequesterGroupMember(ctx, data.RequesterGroupID.ValueInt64(), data.RequesterID.ValueInt64())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error adding requester group member", err)
+		return
+	}
+
+	// Save data into Terraform state
+	data.ID = types.StringValue(requesterGroupMemberID(data.RequesterGroupID.ValueInt64(), data.RequesterID.ValueInt64()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource, the membership is looked up in the members of the group.
+func (r *RequesterGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RequesterGroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.ListRequesterGroupMembers(ctx, data.RequesterGroupID.ValueInt64())
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error listing requester group members", err)
+		return
+	}
+
+	for _, member := range members {
+		if member.ID == data.RequesterID.ValueInt64() {
+			// Save data into Terraform state
+			data.ID = types.StringValue(requesterGroupMemberID(data.RequesterGroupID.ValueInt64(), data.RequesterID.ValueInt64()))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The requester or the whole group was removed outside of Terraform, drop
+	// the membership from state so it gets added again.
+	tflog.Warn(ctx, "Requester group member not found, removing it from state", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update the resource, every argument requires a replacement so there is
+// nothing to send to the API.
+func (r *RequesterGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RequesterGroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource.
+func (r *RequesterGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RequesterGroupMemberResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveRequesterGroupMember(ctx, data.RequesterGroupID.ValueInt64(), data.RequesterID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error removing requester group member", err)
+		return
+	}
+}
+
+// ImportState imports a membership by `<requester_group_id>/<requester_id>`.
+func (r *RequesterGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupPart, requesterPart, found := strings.Cut(req.ID, "/")
+	requesterGroupID, groupErr := strconv.ParseInt(groupPart, 10, 64)
+	requesterID, requesterErr := strconv.ParseInt(requesterPart, 10, 64)
+	if !found || groupErr != nil || requesterErr != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected <requester_group_id>/<requester_id>, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("requester_group_id"), requesterGroupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("requester_id"), requesterID)...)
+}
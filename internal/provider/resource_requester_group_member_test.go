@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccRequesterGroupMemberResource(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	jane := server.AddRequester("Jane", "jane@example.com")
+	john := server.AddRequester("John", "john@example.com")
+	ruleBased := server.AddRequesterGroup("TestAcc Everyone", "rule_based")
+	config := fmt.Sprintf(`
+resource "fresh_requester_group" "test" {
+  name = "TestAcc Finance Requesters"
+}
+
+resource "fresh_requester_group_member" "jane" {
+  requester_group_id = fresh_requester_group.test.id
+  requester_id       = %d
+}
+
+resource "fresh_requester_group_member" "john" {
+  requester_group_id = fresh_requester_group.test.id
+  requester_id       = %d
+}
+`, jane, john)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRequesterGroupDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fresh_requester_group_member.jane", "requester_group_id", "fresh_requester_group.test", "id"),
+					resource.TestCheckResourceAttr("fresh_requester_group_member.jane", "requester_id", fmt.Sprint(jane)),
+					resource.TestMatchResourceAttr("fresh_requester_group_member.john", "id", regexp.MustCompile(fmt.Sprintf(`^\d+/%d$`, john))),
+					resource.TestCheckResourceAttrWith("fresh_requester_group.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_requester_group_member.jane",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Members removed outside of Terraform are added again
+			{
+				PreConfig: func() {
+					groupID, _ := strconv.ParseInt(id, 10, 64)
+					if err := client.RemoveRequesterGroupMember(context.Background(), groupID, john); err != nil {
+						t.Fatalf("freshclient.RemoveRequesterGroupMember() error = %v", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_requester_group_member.john", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("fresh_requester_group_member.jane", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			// Rule based groups refuse members
+			{
+				Config: config + fmt.Sprintf(`
+resource "fresh_requester_group_member" "rule_based" {
+  requester_group_id = %d
+  requester_id       = %d
+}
+`, ruleBased, jane),
+				ExpectError: regexp.MustCompile(`Requesters can only be added to manual\s+requester groups`),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRequesterGroupResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRequesterGroupDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "fresh_requester_group" "test" {
+  name        = "TestAcc Berlin Office"
+  description = "Requesters working from Berlin"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_requester_group.test", "name", "TestAcc Berlin Office"),
+					resource.TestCheckResourceAttr("fresh_requester_group.test", "description", "Requesters working from Berlin"),
+					resource.TestCheckResourceAttr("fresh_requester_group.test", "type", "manual"),
+					resource.TestCheckResourceAttrWith("fresh_requester_group.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "fresh_requester_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing, unset arguments fall back to their defaults
+			{
+				Config: `
+resource "fresh_requester_group" "test" {
+  name = "TestAcc Munich Office"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_requester_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_requester_group.test", "name", "TestAcc Munich Office"),
+					resource.TestCheckResourceAttr("fresh_requester_group.test", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRequesterGroupResourceType(t *testing.T) {
+	client, server := testAccSetup(t)
+	testAccRequireFake(t, server)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRequesterGroupDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+resource "fresh_requester_group" "test" {
+  name = "TestAcc Contractors"
+}
+`,
+				Check: resource.TestCheckResourceAttr("fresh_requester_group.test", "type", "manual"),
+			},
+			// Changing the type replaces the group
+			{
+				Config: `
+resource "fresh_requester_group" "test" {
+  name = "TestAcc Contractors"
+  type = "rule_based"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_requester_group.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_requester_group.test", "type", "rule_based"),
+					resource.TestCheckResourceAttrWith("fresh_requester_group.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckRequesterGroupDestroyed checks that the requester group is gone
+// from the API.
+func testAccCheckRequesterGroupDestroyed(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		groupID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetRequesterGroup(context.Background(), groupID)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("requester group %d still exists: %v", groupID, err)
+	}
+}
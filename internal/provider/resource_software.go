@@ -0,0 +1,482 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure SoftwareResource satisfies various resource interfaces.
+var _ resource.Resource = &SoftwareResource{}
+var _ resource.ResourceWithImportState = &SoftwareResource{}
+
+// NewSoftwareResource returns a new resource.
+func NewSoftwareResource() resource.Resource {
+	return &SoftwareResource{}
+}
+
+// SoftwareResource defines the resource implementation.
+type SoftwareResource struct {
+	client *freshclient.Client
+}
+
+// SoftwareResourceModel describes the resource data model.
+type SoftwareResourceModel struct {
+	ApplicationType   types.String                `tfsdk:"application_type"`
+	Category          types.String                `tfsdk:"category"`
+	CreatedAt         types.String                `tfsdk:"created_at"`
+	Description       types.String                `tfsdk:"description"`
+	ID                types.Int64                 `tfsdk:"id"`
+	InstallationCount types.Int64                 `tfsdk:"installation_count"`
+	Installations     []SoftwareInstallationModel `tfsdk:"installations"`
+	ManagedByID       types.Int64                 `tfsdk:"managed_by_id"`
+	Name              types.String                `tfsdk:"name"`
+	Notes             types.String                `tfsdk:"notes"`
+	PublisherID       types.Int64                 `tfsdk:"publisher_id"`
+	Source            types.String                `tfsdk:"source"`
+	Status            types.String                `tfsdk:"status"`
+	UpdatedAt         types.String                `tfsdk:"updated_at"`
+}
+
+// SoftwareInstallationModel describes an installation of the software on an
+// asset.
+type SoftwareInstallationModel struct {
+	DisplayID        types.Int64  `tfsdk:"display_id"`
+	InstallationDate types.String `tfsdk:"installation_date"`
+	InstallationPath types.String `tfsdk:"installation_path"`
+	Version          types.String `tfsdk:"version"`
+}
+
+// fromFreshApplication converts an application from the API, the
+// installations are read separately and left untouched.
+func (m SoftwareResourceModel) fromFreshApplication(application freshclient.ApplicationDetails) SoftwareResourceModel {
+	return SoftwareResourceModel{
+		ApplicationType:   types.StringValue(application.ApplicationType),
+		Category:          types.StringValue(application.Category),
+		CreatedAt:         types.StringValue(application.CreatedAt),
+		Description:       types.StringValue(application.Description),
+		ID:                types.Int64Value(application.ID),
+		InstallationCount: types.Int64Value(application.InstallationCount),
+		Installations:     m.Installations,
+		ManagedByID:       optionalInt64(application.ManagedByID),
+		Name:              types.StringValue(application.Name),
+		Notes:             types.StringValue(application.Notes),
+		PublisherID:       optionalInt64(application.PublisherID),
+		Source:            types.StringValue(application.Source),
+		Status:            types.StringValue(application.Status),
+		UpdatedAt:         types.StringValue(application.UpdatedAt),
+	}
+}
+
+func (m SoftwareResourceModel) toFreshApplication() freshclient.ApplicationDetails {
+	return freshclient.ApplicationDetails{
+		ApplicationType: m.ApplicationType.ValueString(),
+		Category:        m.Category.ValueString(),
+		Description:     m.Description.ValueString(),
+		ID:              m.ID.ValueInt64(),
+		ManagedByID:     m.ManagedByID.ValueInt64(),
+		Name:            m.Name.ValueString(),
+		Notes:           m.Notes.ValueString(),
+		PublisherID:     m.PublisherID.ValueInt64(),
+		Status:          m.Status.ValueString(),
+	}
+}
+
+// fromFreshInstallation converts an installation from the API, empty fields
+// are null as they are optional.
+func (m SoftwareInstallationModel) fromFreshInstallation(installation freshclient.InstallationDetails) SoftwareInstallationModel {
+	return SoftwareInstallationModel{
+		DisplayID:        types.Int64Value(installation.InstallationMachineID),
+		InstallationDate: optionalString(installation.InstallationDate),
+		InstallationPath: optionalString(installation.InstallationPath),
+		Version:          optionalString(installation.Version),
+	}
+}
+
+func (m SoftwareInstallationModel) toFreshInstallation() freshclient.InstallationDetails {
+	return freshclient.InstallationDetails{
+		InstallationDate:      m.InstallationDate.ValueString(),
+		InstallationMachineID: m.DisplayID.ValueInt64(),
+		InstallationPath:      m.InstallationPath.ValueString(),
+		Version:               m.Version.ValueString(),
+	}
+}
+
+// optionalInt64 returns null for an ID the API reports as 0.
+func optionalInt64(value int64) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(value)
+}
+
+// optionalString returns null for a string the API reports as empty.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// Metadata returns the metadata for the resource.
+func (r *SoftwareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_software"
+}
+
+// Schema returns the schema for the resource.
+func (r *SoftwareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Software Resource, manages a software application and optionally the assets it is installed on",
+
+		Attributes: map[string]schema.Attribute{
+			"application_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the software, one of `desktop`, `saas` or `mobile`, defaults to `desktop`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("desktop"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("desktop", "saas", "mobile"),
+				},
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category of the software, e.g. `Productivity`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of creation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the software",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Unique ID of the software",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"installation_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of assets the software is installed on",
+				Computed:            true,
+			},
+			"installations": schema.SetNestedAttribute{
+				MarkdownDescription: "Assets the software is installed on. Leave unset to not manage the installations, " +
+					"once set every installation not listed is removed",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_id": schema.Int64Attribute{
+							MarkdownDescription: "Display ID of the asset the software is installed on",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"installation_date": schema.StringAttribute{
+							MarkdownDescription: "Date and time of the installation, e.g. `2023-11-24T10:00:00Z`",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(dateTimePattern, "must be a date and time like 2023-11-24T10:00:00Z"),
+							},
+						},
+						"installation_path": schema.StringAttribute{
+							MarkdownDescription: "Path the software is installed in",
+							Optional:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Installed version of the software",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"managed_by_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the agent managing the software, see the `fresh_agent` resource and data source",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the software, must be unique",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"notes": schema.StringAttribute{
+				MarkdownDescription: "Notes about the software",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"publisher_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the vendor publishing the software",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Source the software was discovered by, e.g. `API`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the software, one of `managed`, `ignored` or `blacklisted`, defaults to `managed`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("managed"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("managed", "ignored", "blacklisted"),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time of last update",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *SoftwareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*freshclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			"the provider data was not the expected type",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create the resource.
+func (r *SoftwareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SoftwareResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationDetails, err := r.client.CreateApplication(ctx, data.toFreshApplication())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error creating software", err, attributeFieldPath(SoftwareResourceModel{}))
+		return
+	}
+	data = data.fromFreshApplication(*applicationDetails)
+
+	if data.Installations != nil {
+		resp.Diagnostics.Append(r.syncInstallations(ctx, data.ID.ValueInt64(), nil, data.Installations)...)
+		r.refreshInstallationCount(ctx, &data)
+	}
+
+	// Save data into Terraform state, also when an installation failed so the
+	// software is not left behind untracked.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read the resource and convert it into a resource object.
+func (r *SoftwareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SoftwareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationDetails, err := r.client.GetApplication(ctx, data.ID.ValueInt64())
+
+	// The software was deleted outside of Terraform, drop it from state so it
+	// gets created again.
+	if freshclient.IsNotFound(err) {
+		tflog.Warn(ctx, "Software not found, removing it from state", map[string]interface{}{
+			"id": data.ID.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error getting software", err)
+		return
+	}
+	data = data.fromFreshApplication(*applicationDetails)
+
+	// Only read the installations when they are managed.
+	if data.Installations != nil {
+		installations, err := r.client.ListApplicationInstallations(ctx, data.ID.ValueInt64())
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Error listing software installations", err)
+			return
+		}
+
+		data.Installations = make([]SoftwareInstallationModel, 0, len(installations))
+		for _, installation := range installations {
+			data.Installations = append(data.Installations, SoftwareInstallationModel{}.fromFreshInstallation(installation))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update the resource.
+func (r *SoftwareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SoftwareResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationDetails, err := r.client.UpdateApplication(ctx, data.toFreshApplication())
+	if err != nil {
+		addAPIFieldError(&resp.Diagnostics, "Error updating software", err, attributeFieldPath(SoftwareResourceModel{}))
+		return
+	}
+	data = data.fromFreshApplication(*applicationDetails)
+
+	if data.Installations != nil {
+		resp.Diagnostics.Append(r.syncInstallations(ctx, data.ID.ValueInt64(), state.Installations, data.Installations)...)
+		r.refreshInstallationCount(ctx, &data)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete the resource, FreshService deletes the installations with it.
+func (r *SoftwareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SoftwareResourceModel
+
+	// Read the resource data from Terraform into the data model.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteApplication(ctx, data.ID.ValueInt64())
+
+	// Already gone, nothing left to delete.
+	if err != nil && !freshclient.IsNotFound(err) {
+		addAPIError(&resp.Diagnostics, "Error deleting software", err)
+		return
+	}
+}
+
+// ImportState imports software by its ID, the installations are not managed
+// until they are set in the configuration.
+func (r *SoftwareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected the ID of the software, got: "+req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// syncInstallations makes the installations of the software match planned.
+// The API cannot update an installation, so changed installations are
+// removed and recorded again.
+func (r *SoftwareResource) syncInstallations(ctx context.Context, applicationID int64, current []SoftwareInstallationModel, planned []SoftwareInstallationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plannedByAsset := map[int64]SoftwareInstallationModel{}
+	for _, installation := range planned {
+		displayID := installation.DisplayID.ValueInt64()
+		if _, ok := plannedByAsset[displayID]; ok {
+			diags.AddAttributeError(path.Root("installations"), "Duplicate installation",
+				fmt.Sprintf("asset %d is listed more than once, software can be installed on an asset only once", displayID))
+			return diags
+		}
+		plannedByAsset[displayID] = installation
+	}
+
+	currentByAsset := map[int64]SoftwareInstallationModel{}
+	var removed []int64
+	for _, installation := range current {
+		displayID := installation.DisplayID.ValueInt64()
+		currentByAsset[displayID] = installation
+		if other, ok := plannedByAsset[displayID]; !ok || other != installation {
+			removed = append(removed, displayID)
+		}
+	}
+
+	if len(removed) > 0 {
+		if err := r.client.DeleteApplicationInstallations(ctx, applicationID, removed...); err != nil && !freshclient.IsNotFound(err) {
+			addAPIError(&diags, "Error removing software installations", err)
+			return diags
+		}
+	}
+
+	for _, installation := range planned {
+		if other, ok := currentByAsset[installation.DisplayID.ValueInt64()]; ok && other == installation {
+			continue
+		}
+		if _, err := r.client.CreateApplicationInstallation(ctx, applicationID, installation.toFreshInstallation()); err != nil {
+			addAPIError(&diags, fmt.Sprintf("Error installing software on asset %d", installation.DisplayID.ValueInt64()), err)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// refreshInstallationCount reads the installation count again after the
+// installations changed.
+func (r *SoftwareResource) refreshInstallationCount(ctx context.Context, data *SoftwareResourceModel) {
+	applicationDetails, err := r.client.GetApplication(ctx, data.ID.ValueInt64())
+	if err != nil {
+		tflog.Warn(ctx, "Could not read the installation count of the software", map[string]interface{}{
+			"id":    data.ID.ValueInt64(),
+			"error": err.Error(),
+		})
+		return
+	}
+
+	data.InstallationCount = types.Int64Value(applicationDetails.InstallationCount)
+	data.UpdatedAt = types.StringValue(applicationDetails.UpdatedAt)
+}
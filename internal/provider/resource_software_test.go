@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSoftwareResource(t *testing.T) {
+	client, _ := testAccSetup(t)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSoftwareDestroyed(client, &id),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSoftwareResourceConfig("Browsers", `
+    {
+      display_id = fresh_asset.laptop.display_id
+      version    = "118.0"
+    },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_software.test", "name", "TestAcc Browser"),
+					resource.TestCheckResourceAttr("fresh_software.test", "application_type", "desktop"),
+					resource.TestCheckResourceAttr("fresh_software.test", "status", "managed"),
+					resource.TestCheckResourceAttr("fresh_software.test", "category", "Browsers"),
+					resource.TestCheckResourceAttr("fresh_software.test", "installation_count", "1"),
+					resource.TestCheckResourceAttr("fresh_software.test", "installations.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("fresh_software.test", "installations.*", map[string]string{
+						"version": "118.0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("fresh_software.test", "installations.*.display_id", "fresh_asset.laptop", "display_id"),
+					resource.TestCheckResourceAttrWith("fresh_software.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing, imported software does not manage its installations
+			{
+				ResourceName:            "fresh_software.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"installations"},
+			},
+			// Update and installation change testing
+			{
+				Config: testAccSoftwareResourceConfig("Internet", `
+    {
+      display_id = fresh_asset.laptop.display_id
+      version    = "119.0"
+    },
+    {
+      display_id        = fresh_asset.desktop.display_id
+      version           = "119.0"
+      installation_path = "C:\\Program Files\\Browser"
+      installation_date = "2023-11-24T10:00:00Z"
+    },`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_software.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("fresh_software.test", "category", "Internet"),
+					resource.TestCheckResourceAttr("fresh_software.test", "installation_count", "2"),
+					resource.TestCheckResourceAttr("fresh_software.test", "installations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("fresh_software.test", "installations.*", map[string]string{
+						"version":           "119.0",
+						"installation_path": `C:\Program Files\Browser`,
+						"installation_date": "2023-11-24T10:00:00Z",
+					}),
+				),
+			},
+			// Out of band uninstall testing
+			{
+				PreConfig: func() {
+					softwareID, _ := strconv.ParseInt(id, 10, 64)
+					installations, err := client.ListApplicationInstallations(context.Background(), softwareID)
+					if err != nil || len(installations) == 0 {
+						t.Fatalf("freshclient.ListApplicationInstallations() = %v, %v", installations, err)
+					}
+					if err := client.DeleteApplicationInstallations(context.Background(), softwareID, installations[0].InstallationMachineID); err != nil {
+						t.Fatalf("freshclient.DeleteApplicationInstallations() error = %v", err)
+					}
+				},
+				Config: testAccSoftwareResourceConfig("Internet", `
+    {
+      display_id = fresh_asset.laptop.display_id
+      version    = "119.0"
+    },
+    {
+      display_id        = fresh_asset.desktop.display_id
+      version           = "119.0"
+      installation_path = "C:\\Program Files\\Browser"
+      installation_date = "2023-11-24T10:00:00Z"
+    },`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("fresh_software.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("fresh_software.test", "installation_count", "2"),
+			},
+		},
+	})
+}
+
+// testAccCheckSoftwareDestroyed checks that the software is gone from the API.
+func testAccCheckSoftwareDestroyed(client *freshclient.Client, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		softwareID, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetApplication(context.Background(), softwareID)
+		if freshclient.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("software %d still exists: %v", softwareID, err)
+	}
+}
+
+func testAccSoftwareResourceConfig(category string, installations string) string {
+	return fmt.Sprintf(`
+data "fresh_asset_type" "test" {
+  name = %[1]q
+}
+
+resource "fresh_asset" "laptop" {
+  name          = "TestAccSoftwareLaptop"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_asset" "desktop" {
+  name          = "TestAccSoftwareDesktop"
+  asset_type_id = data.fresh_asset_type.test.id
+}
+
+resource "fresh_software" "test" {
+  name     = "TestAcc Browser"
+  category = %[2]q
+
+  installations = [%[3]s
+  ]
+}
+`, testAccAssetTypeName, category, installations)
+}
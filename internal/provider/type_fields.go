@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"terraform-provider-fresh/internal/freshclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// integerPattern and decimalPattern match the values accepted by number and
+// decimal type fields.
+var (
+	integerPattern = regexp.MustCompile(`^-?\d+$`)
+	decimalPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+// typeFieldsValue converts the type fields returned by the API into a map
+// keyed by plain field names, fields without a value are left out. The map is
+// null when the API did not return type fields.
+func typeFieldsValue(typeFields map[string]interface{}) types.Map {
+	if typeFields == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := map[string]attr.Value{}
+	for name, value := range freshclient.TrimTypeFields(typeFields) {
+		if value == nil {
+			continue
+		}
+		elements[name] = types.StringValue(typeFieldString(value))
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// managedTypeFieldsValue converts the type fields returned by the API into a
+// map holding only the keys of managed, so fields which are not part of the
+// configuration do not show up as changes.
+func managedTypeFieldsValue(typeFields map[string]interface{}, managed types.Map) types.Map {
+	if managed.IsNull() || managed.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := map[string]attr.Value{}
+	for key := range managed.Elements() {
+		value, _ := freshclient.LookupTypeField(typeFields, key)
+		if value == nil {
+			elements[key] = types.StringValue("")
+			continue
+		}
+		elements[key] = types.StringValue(typeFieldString(value))
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// typeFieldsFromValue converts a map of type fields from Terraform into the
+// values sent to the API, keyed by the names used in the configuration.
+func typeFieldsFromValue(value types.Map) map[string]interface{} {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	typeFields := map[string]interface{}{}
+	for key, element := range value.Elements() {
+		if str, ok := element.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
+			typeFields[key] = str.ValueString()
+		}
+	}
+
+	return typeFields
+}
+
+// updatedFieldsFromValue converts a map of type or custom fields from the plan
+// into the values sent to the API on update, keys only in state are no longer
+// managed and sent as null to clear them.
+func updatedFieldsFromValue(plan types.Map, state types.Map) map[string]interface{} {
+	fields := typeFieldsFromValue(plan)
+	for name := range typeFieldsFromValue(state) {
+		if _, ok := fields[name]; !ok {
+			if fields == nil {
+				fields = map[string]interface{}{}
+			}
+			fields[name] = nil
+		}
+	}
+
+	return fields
+}
+
+// typeFieldsRequest returns the type fields to send to the API for an asset
+// type with the given fields. Values are converted to the data type of their
+// field and keyed like ExpandTypeFields, names the asset type has no field
+// for are reported on diags.
+func typeFieldsRequest(diags *diag.Diagnostics, typeFields map[string]interface{}, assetTypeID int64, fields []freshclient.AssetTypeFieldDetails) map[string]interface{} {
+	if typeFields == nil {
+		return nil
+	}
+
+	dataTypes := map[string]string{}
+	for _, field := range fields {
+		if field.IsTypeField() {
+			dataTypes[field.Name] = field.DataType
+			dataTypes[field.PlainName()] = field.DataType
+		}
+	}
+
+	converted := make(map[string]interface{}, len(typeFields))
+	for name, value := range typeFields {
+		dataType, ok := dataTypes[name]
+		switch {
+		case !ok && value == nil:
+			// Nothing to clear on a field the asset type no longer has.
+		case !ok:
+			diags.AddAttributeError(path.Root("type_fields").AtMapKey(name), "Unknown type field",
+				fmt.Sprintf("Asset type %d has no type field named %q, see the fresh_asset_type_fields data source for the available fields.", assetTypeID, name))
+		case value == nil:
+			converted[name] = nil
+		default:
+			typed, err := typeFieldValue(dataType, fmt.Sprint(value))
+			if err != nil {
+				diags.AddAttributeError(path.Root("type_fields").AtMapKey(name), "Invalid type field value", err.Error())
+				continue
+			}
+			converted[name] = typed
+		}
+	}
+
+	return freshclient.ExpandTypeFields(converted, assetTypeID, freshclient.KnownTypeFields(fields))
+}
+
+// typeFieldValue converts a type field value from the configuration to the
+// JSON type of its data type, an empty value clears the field.
+func typeFieldValue(dataType string, value string) (interface{}, error) {
+	if value == "" && dataType != "text" && dataType != "paragraph" {
+		return nil, nil
+	}
+
+	switch dataType {
+	case "number":
+		if !integerPattern.MatchString(value) {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return json.Number(value), nil
+	case "decimal":
+		if !decimalPattern.MatchString(value) {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return json.Number(value), nil
+	case "checkbox":
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("%q is not a boolean, use true or false", value)
+	case "date":
+		if !datePattern.MatchString(value) && !dateTimePattern.MatchString(value) {
+			return nil, fmt.Errorf("%q is not a date, use YYYY-MM-DD or YYYY-MM-DDThh:mm:ssZ", value)
+		}
+	}
+
+	return value, nil
+}
+
+// typeFieldString formats a type field value returned by the API.
+func typeFieldString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// customFieldsValue converts the custom fields returned by the API into a
+// map, fields without a value are left out.
+func customFieldsValue(customFields map[string]interface{}) types.Map {
+	elements := map[string]attr.Value{}
+	for name, value := range customFields {
+		if value == nil {
+			continue
+		}
+		elements[name] = types.StringValue(typeFieldString(value))
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// managedCustomFieldsValue converts the custom fields returned by the API into
+// a map holding only the keys of managed, like managedTypeFieldsValue.
+func managedCustomFieldsValue(customFields map[string]interface{}, managed types.Map) types.Map {
+	if managed.IsNull() || managed.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := map[string]attr.Value{}
+	for key := range managed.Elements() {
+		value := customFields[key]
+		if value == nil {
+			elements[key] = types.StringValue("")
+			continue
+		}
+		elements[key] = types.StringValue(typeFieldString(value))
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}